@@ -0,0 +1,56 @@
+package bamboo
+
+import "net/http"
+
+// CurrentActivity is a snapshot of what the server is doing
+// - Busy:              Online agents running a build or deployment, with their CurrentJob set
+// - Idle:              Online agents waiting for work
+// - QueuedBuilds:      Builds waiting for an agent
+// - QueuedDeployments: Deployments waiting for an agent
+type CurrentActivity struct {
+	Busy              []*Agent
+	Idle              []*Agent
+	QueuedBuilds      []*QueuedBuild
+	QueuedDeployments []*QueueDeployRequest
+}
+
+// CurrentActivity returns what each online agent is doing together with the
+// builds and deployments waiting in the queue
+func (a *AgentService) CurrentActivity() (*CurrentActivity, *http.Response, error) {
+	agents, response, err := a.ListAgents(&AgentListOptions{Online: true})
+	if err != nil {
+		return nil, response, err
+	}
+
+	activity := &CurrentActivity{Busy: []*Agent{}, Idle: []*Agent{}}
+	for _, agent := range agents {
+		if !agent.Busy {
+			activity.Idle = append(activity.Idle, agent)
+			continue
+		}
+
+		// The agent list doesn't include the running job
+		busy, response, err := a.GetAgent(agent.ID)
+		switch {
+		case err == ErrNotFound:
+			// The agent went away since it was listed
+			continue
+		case err != nil:
+			return nil, response, err
+		}
+		activity.Busy = append(activity.Busy, busy)
+	}
+
+	queue := (*QueueService)(a)
+	activity.QueuedBuilds, response, err = queue.queuedBuilds()
+	if err != nil {
+		return nil, response, err
+	}
+
+	activity.QueuedDeployments, response, err = queue.ListQueuedDeployments()
+	if err != nil {
+		return nil, response, err
+	}
+
+	return activity, response, nil
+}
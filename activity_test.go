@@ -0,0 +1,43 @@
+package bamboo_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	bamboo "github.com/sukhyun/go-bamboo"
+)
+
+func TestCurrentActivity(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/rest/api/latest/agent":
+			assert.Equal(t, "true", r.URL.Query().Get("online"))
+			w.Write([]byte(`[{"id":1,"name":"linux-1","active":true,"busy":true},{"id":2,"name":"linux-2","active":true,"busy":false}]`))
+		case "/rest/api/latest/agent/1":
+			w.Write([]byte(`{"id":1,"name":"linux-1","active":true,"busy":true,"currentJob":{"buildResultKey":"CORE-TEST-JOB1-7","name":"Default Job"}}`))
+		case "/rest/api/latest/queue.json":
+			w.Write([]byte(`{"queuedBuilds":{"size":1,"queuedBuild":[{"planKey":"CORE-TEST","buildNumber":8,"buildResultKey":"CORE-TEST-8"}]}}`))
+		case "/rest/api/latest/queue/deployment":
+			w.Write([]byte(`{"queuedDeployments":{"size":1,"queuedDeployment":[{"deploymentResultId":42}]}}`))
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	activity, _, err := client.Agents.CurrentActivity()
+	assert.NoError(t, err)
+	assert.Len(t, activity.Busy, 1)
+	assert.Equal(t, "CORE-TEST-JOB1-7", activity.Busy[0].CurrentJob.BuildResultKey)
+	assert.Len(t, activity.Idle, 1)
+	assert.Equal(t, int64(2), activity.Idle[0].ID)
+	assert.Len(t, activity.QueuedBuilds, 1)
+	assert.Equal(t, "CORE-TEST-8", activity.QueuedBuilds[0].BuildResultKey)
+	assert.Len(t, activity.QueuedDeployments, 1)
+	assert.Equal(t, 42, activity.QueuedDeployments[0].DeploymentResultID)
+}
@@ -0,0 +1,181 @@
+package bamboo
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+// AgentExecutorType is the executor type of a local or remote agent
+const AgentExecutorType string = "AGENT"
+
+// ImageExecutorType is the executor type of an elastic image configuration
+const ImageExecutorType string = "IMAGE"
+
+// ProjectAssignmentType is the assignment type of an agent dedicated to a build project
+const ProjectAssignmentType string = "PROJECT"
+
+// PlanAssignmentType is the assignment type of an agent dedicated to a plan
+const PlanAssignmentType string = "PLAN"
+
+// JobAssignmentType is the assignment type of an agent dedicated to a job
+const JobAssignmentType string = "JOB"
+
+// DeploymentProjectAssignmentType is the assignment type of an agent dedicated to a deployment project
+const DeploymentProjectAssignmentType string = "DEPLOYMENT_PROJECT"
+
+// EnvironmentAssignmentType is the assignment type of an agent dedicated to a deployment environment
+const EnvironmentAssignmentType string = "ENVIRONMENT"
+
+var knownAssignmentTypes = map[string]bool{
+	ProjectAssignmentType:           true,
+	PlanAssignmentType:              true,
+	JobAssignmentType:               true,
+	DeploymentProjectAssignmentType: true,
+	EnvironmentAssignmentType:       true,
+}
+
+// AgentAssignment dedicates an agent or elastic image to a build or deployment entity
+// - ExecutorType:   AgentExecutorType or ImageExecutorType
+// - AssignmentType: The type of the entity, e.g. EnvironmentAssignmentType
+// - EntityID:       The ID of the entity, e.g. the environment ID
+type AgentAssignment struct {
+	ExecutorType   string `json:"executorType"`
+	ExecutorID     int64  `json:"executorId"`
+	ExecutorName   string `json:"executorName,omitempty"`
+	AssignmentType string `json:"assignmentType"`
+	EntityID       int64  `json:"entityId"`
+	EntityName     string `json:"entityName,omitempty"`
+}
+
+// Assignments returns the entities the given agent or elastic image is dedicated to
+func (a *AgentService) Assignments(executorType string, executorID int64) ([]*AgentAssignment, *http.Response, error) {
+	if executorType != AgentExecutorType && executorType != ImageExecutorType {
+		return nil, nil, &simpleError{fmt.Sprintf("Unknown executor type %s", executorType)}
+	}
+
+	values := map[string]string{
+		"executorType": executorType,
+		"executorId":   strconv.FormatInt(executorID, 10),
+	}
+	return a.listAssignments(values)
+}
+
+// AddAssignment dedicates an agent or elastic image to a build or deployment entity
+func (a *AgentService) AddAssignment(assignment *AgentAssignment) (*http.Response, error) {
+	return a.changeAssignment(http.MethodPut, assignment)
+}
+
+// RemoveAssignment removes the dedication of an agent or elastic image to a build or deployment entity
+func (a *AgentService) RemoveAssignment(assignment *AgentAssignment) (*http.Response, error) {
+	return a.changeAssignment(http.MethodDelete, assignment)
+}
+
+func (a *AgentService) listAssignments(params map[string]string) ([]*AgentAssignment, *http.Response, error) {
+	request, err := a.client.NewRequest(http.MethodGet, "agent/assignment", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	values := request.URL.Query()
+	for name, value := range params {
+		values.Set(name, value)
+	}
+	request.URL.RawQuery = values.Encode()
+
+	assignments := []*AgentAssignment{}
+	response, err := a.client.Do(request, &assignments)
+	if err != nil {
+		return nil, response, err
+	}
+
+	if response.StatusCode != 200 {
+		return nil, response, &simpleError{fmt.Sprintf("Listing agent assignments returned %s", response.Status)}
+	}
+
+	return assignments, response, nil
+}
+
+func (a *AgentService) changeAssignment(method string, assignment *AgentAssignment) (*http.Response, error) {
+	if assignment == nil {
+		return nil, &simpleError{"Assignment cannot be nil"}
+	}
+	if assignment.ExecutorType != AgentExecutorType && assignment.ExecutorType != ImageExecutorType {
+		return nil, &simpleError{fmt.Sprintf("Unknown executor type %s", assignment.ExecutorType)}
+	}
+	if !knownAssignmentTypes[assignment.AssignmentType] {
+		return nil, &simpleError{fmt.Sprintf("Unknown assignment type %s", assignment.AssignmentType)}
+	}
+
+	request, err := a.client.NewRequest(method, "agent/assignment", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	values := request.URL.Query()
+	values.Set("executorType", assignment.ExecutorType)
+	values.Set("executorId", strconv.FormatInt(assignment.ExecutorID, 10))
+	values.Set("assignmentType", assignment.AssignmentType)
+	values.Set("entityId", strconv.FormatInt(assignment.EntityID, 10))
+	request.URL.RawQuery = values.Encode()
+
+	response, err := a.client.Do(request, nil)
+	if err != nil {
+		return response, err
+	}
+
+	if response.StatusCode != 200 && response.StatusCode != 204 {
+		return response, &simpleError{fmt.Sprintf("Changing the %s assignment of %s %d returned %s",
+			assignment.AssignmentType, assignment.ExecutorType, assignment.ExecutorID, response.Status)}
+	}
+
+	return response, nil
+}
+
+// EnvironmentAgents returns the agents and elastic images dedicated to the given environment
+func (d *DeployService) EnvironmentAgents(environmentID int) ([]*AgentAssignment, error) {
+	values := map[string]string{
+		"assignmentType": EnvironmentAssignmentType,
+		"entityId":       strconv.Itoa(environmentID),
+	}
+	assignments, response, err := (*AgentService)(d).listAssignments(values)
+	if err != nil {
+		return nil, assignmentErr(response, err, "Error listing environment agents")
+	}
+	return assignments, nil
+}
+
+// AssignEnvironmentAgent dedicates the given agent or elastic image to the given environment
+func (d *DeployService) AssignEnvironmentAgent(environmentID int, executorType string, executorID int64) error {
+	response, err := (*AgentService)(d).AddAssignment(environmentAssignment(environmentID, executorType, executorID))
+	if err != nil {
+		return assignmentErr(response, err, "Error assigning environment agent")
+	}
+	return nil
+}
+
+// UnassignEnvironmentAgent removes the dedication of the given agent or elastic image to the given environment
+func (d *DeployService) UnassignEnvironmentAgent(environmentID int, executorType string, executorID int64) error {
+	response, err := (*AgentService)(d).RemoveAssignment(environmentAssignment(environmentID, executorType, executorID))
+	if err != nil {
+		return assignmentErr(response, err, "Error unassigning environment agent")
+	}
+	return nil
+}
+
+// assignmentErr turns a failed assignment request into the error the other DeployService methods return
+func assignmentErr(response *http.Response, err error, msg string) error {
+	if response != nil && response.StatusCode >= 300 {
+		return newRespErr(response, msg)
+	}
+	return err
+}
+
+func environmentAssignment(environmentID int, executorType string, executorID int64) *AgentAssignment {
+	return &AgentAssignment{
+		ExecutorType:   executorType,
+		ExecutorID:     executorID,
+		AssignmentType: EnvironmentAssignmentType,
+		EntityID:       int64(environmentID),
+	}
+}
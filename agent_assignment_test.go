@@ -0,0 +1,100 @@
+package bamboo_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	bamboo "github.com/sukhyun/go-bamboo"
+)
+
+func TestEnvironmentAgents(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(environmentAgentsStub))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	assignments, err := client.Deploys.EnvironmentAgents(131)
+	assert.NoError(t, err)
+	if assert.Len(t, assignments, 1) {
+		assert.Equal(t, "hardened-agent-1", assignments[0].ExecutorName)
+	}
+
+	assert.NoError(t, client.Deploys.AssignEnvironmentAgent(131, bamboo.AgentExecutorType, 131073))
+	assert.NoError(t, client.Deploys.UnassignEnvironmentAgent(131, bamboo.AgentExecutorType, 131073))
+	assert.Error(t, client.Deploys.AssignEnvironmentAgent(131, "LOCAL", 131073))
+	assert.Error(t, client.Deploys.AssignEnvironmentAgent(131, bamboo.AgentExecutorType, 42))
+
+	_, err = client.Deploys.EnvironmentAgents(132)
+	assert.Error(t, err)
+}
+
+func environmentAgentsStub(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	if r.URL.Path != "/rest/api/latest/agent/assignment" || q.Get("assignmentType") != bamboo.EnvironmentAssignmentType || q.Get("entityId") != "131" {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		w.Write([]byte(`[{"executorType":"AGENT","executorId":131073,"executorName":"hardened-agent-1","assignmentType":"ENVIRONMENT","entityId":131,"entityName":"Production"}]`))
+	case http.MethodPut, http.MethodDelete:
+		if q.Get("executorType") != bamboo.AgentExecutorType || q.Get("executorId") != "131073" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func TestAgentAssignments(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(agentAssignmentsStub))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	assignments, _, err := client.Agents.Assignments(bamboo.AgentExecutorType, 131073)
+	assert.NoError(t, err)
+	if assert.Len(t, assignments, 2) {
+		assert.Equal(t, bamboo.ProjectAssignmentType, assignments[0].AssignmentType)
+	}
+
+	assignment := &bamboo.AgentAssignment{
+		ExecutorType:   bamboo.AgentExecutorType,
+		ExecutorID:     131073,
+		AssignmentType: bamboo.DeploymentProjectAssignmentType,
+		EntityID:       65,
+	}
+	_, err = client.Agents.AddAssignment(assignment)
+	assert.NoError(t, err)
+	_, err = client.Agents.RemoveAssignment(assignment)
+	assert.NoError(t, err)
+
+	assignment.AssignmentType = "BRANCH"
+	_, err = client.Agents.AddAssignment(assignment)
+	assert.Error(t, err)
+}
+
+func agentAssignmentsStub(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	if r.URL.Path != "/rest/api/latest/agent/assignment" || q.Get("executorType") != bamboo.AgentExecutorType || q.Get("executorId") != "131073" {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		w.Write([]byte(`[{"executorType":"AGENT","executorId":131073,"assignmentType":"PROJECT","entityId":98305,"entityName":"Core"},
+			{"executorType":"AGENT","executorId":131073,"assignmentType":"ENVIRONMENT","entityId":131,"entityName":"Production"}]`))
+	case http.MethodPut, http.MethodDelete:
+		if q.Get("assignmentType") != bamboo.DeploymentProjectAssignmentType || q.Get("entityId") != "65" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
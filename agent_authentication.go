@@ -0,0 +1,76 @@
+package bamboo
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// AgentAuthentication is a request of a remote agent to connect to the server
+// - UUID:     The identifier the agent authenticates with
+// - IP:       The address the agent connected from
+// - Approved: True once an administrator approved the agent
+type AgentAuthentication struct {
+	UUID     string `json:"uuid"`
+	IP       string `json:"ip"`
+	Approved bool   `json:"approved"`
+}
+
+// ListAgentAuthentications returns the authentication requests of remote agents.
+// Set pending to only return requests which haven't been approved yet.
+func (a *AgentService) ListAgentAuthentications(pending bool) ([]*AgentAuthentication, *http.Response, error) {
+	request, err := a.client.NewRequest(http.MethodGet, "agent/authentication", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if pending {
+		values := request.URL.Query()
+		values.Set("pending", "true")
+		request.URL.RawQuery = values.Encode()
+	}
+
+	authentications := []*AgentAuthentication{}
+	response, err := a.client.Do(request, &authentications)
+	if err != nil {
+		return nil, response, err
+	}
+
+	if response.StatusCode != 200 {
+		return nil, response, &simpleError{fmt.Sprintf("Listing agent authentications returned %s", response.Status)}
+	}
+
+	return authentications, response, nil
+}
+
+// ApproveAgent allows the remote agent with the given UUID to connect to the server
+func (a *AgentService) ApproveAgent(uuid string) (*http.Response, error) {
+	return a.changeAgentAuthentication(http.MethodPut, uuid)
+}
+
+// RevokeAgent removes the approval of the remote agent with the given UUID
+func (a *AgentService) RevokeAgent(uuid string) (*http.Response, error) {
+	return a.changeAgentAuthentication(http.MethodDelete, uuid)
+}
+
+func (a *AgentService) changeAgentAuthentication(method, uuid string) (*http.Response, error) {
+	if emptyStrings(uuid) {
+		return nil, &simpleError{"Agent UUID cannot be empty"}
+	}
+
+	request, err := a.client.NewRequest(method, fmt.Sprintf("agent/authentication/%s", url.PathEscape(uuid)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := a.client.Do(request, nil)
+	if err != nil {
+		return response, err
+	}
+
+	if response.StatusCode != 200 && response.StatusCode != 204 {
+		return response, &simpleError{fmt.Sprintf("Changing the authentication of agent %s returned %s", uuid, response.Status)}
+	}
+
+	return response, nil
+}
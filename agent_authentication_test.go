@@ -0,0 +1,45 @@
+package bamboo_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	bamboo "github.com/sukhyun/go-bamboo"
+)
+
+const agentUUID = "a2f6b1e0-8c1d-4f1a-9d4e-1c2b3a4d5e6f"
+
+func TestAgentAuthentications(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(agentAuthenticationsStub))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	pending, _, err := client.Agents.ListAgentAuthentications(true)
+	assert.NoError(t, err)
+	if assert.Len(t, pending, 1) {
+		assert.Equal(t, agentUUID, pending[0].UUID)
+		assert.False(t, pending[0].Approved)
+	}
+
+	_, err = client.Agents.ApproveAgent(agentUUID)
+	assert.NoError(t, err)
+	_, err = client.Agents.RevokeAgent(agentUUID)
+	assert.NoError(t, err)
+	_, err = client.Agents.ApproveAgent("")
+	assert.Error(t, err)
+}
+
+func agentAuthenticationsStub(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == "/rest/api/latest/agent/authentication" && r.URL.Query().Get("pending") == "true":
+		w.Write([]byte(`[{"uuid":"` + agentUUID + `","ip":"10.0.3.17","approved":false}]`))
+	case (r.Method == http.MethodPut || r.Method == http.MethodDelete) && r.URL.Path == "/rest/api/latest/agent/authentication/"+agentUUID:
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusBadRequest)
+	}
+}
@@ -0,0 +1,150 @@
+package bamboo
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// LocalAgentType is the type of an agent running inside the Bamboo server
+const LocalAgentType string = "LOCAL"
+
+// RemoteAgentType is the type of an agent running on its own machine
+const RemoteAgentType string = "REMOTE"
+
+// ElasticAgentType is the type of an agent running on an elastic instance
+const ElasticAgentType string = "ELASTIC"
+
+// AgentService handles communication with the build agents
+type AgentService service
+
+// Agent represents a single build agent
+// - Type:       LocalAgentType, RemoteAgentType or ElasticAgentType
+// - Active:     True if the agent is online
+// - Busy:       True if the agent is running a build or deployment
+// - CurrentJob: What the agent is running, only set by GetAgent
+type Agent struct {
+	ID         int64     `json:"id"`
+	Name       string    `json:"name"`
+	Type       string    `json:"type"`
+	Active     bool      `json:"active"`
+	Enabled    bool      `json:"enabled"`
+	Busy       bool      `json:"busy"`
+	CurrentJob *AgentJob `json:"currentJob,omitempty"`
+}
+
+// AgentJob is the build or deployment an agent is running
+// - BuildResultKey:     The job result being built, e.g. "PROJ-PLAN-JOB1-123", empty for deployments
+// - DeploymentResultID: The deployment result being deployed, zero for builds
+// - StartedTime:        When the agent started running the job
+type AgentJob struct {
+	BuildResultKey     string `json:"buildResultKey,omitempty"`
+	DeploymentResultID int    `json:"deploymentResultId,omitempty"`
+	Name               string `json:"name"`
+	StartedTime        string `json:"startedTime,omitempty"`
+}
+
+// IsDeployment reports whether the job is a deployment rather than a build
+func (j *AgentJob) IsDeployment() bool {
+	return j.DeploymentResultID != 0
+}
+
+// AgentListOptions specifies the optional parameters
+// for listing agents
+// - Online:  Only return agents which are online
+// - Offline: Only return agents which are offline
+// - Type:    Only return agents of the given type, e.g. RemoteAgentType
+type AgentListOptions struct {
+	Online  bool
+	Offline bool
+	Type    string
+}
+
+func (o *AgentListOptions) matches(agent *Agent) bool {
+	if o == nil {
+		return true
+	}
+	if o.Offline && agent.Active {
+		return false
+	}
+	return o.Type == "" || agent.Type == o.Type
+}
+
+// ListAgents returns the build agents of the server
+func (a *AgentService) ListAgents(options *AgentListOptions) ([]*Agent, *http.Response, error) {
+	if options != nil && options.Online && options.Offline {
+		return nil, nil, &simpleError{"Agents cannot be both online and offline"}
+	}
+
+	request, err := a.client.NewRequest(http.MethodGet, "agent", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if options != nil && options.Online {
+		values := request.URL.Query()
+		values.Set("online", "true")
+		request.URL.RawQuery = values.Encode()
+	}
+
+	agents := []*Agent{}
+	response, err := a.client.Do(request, &agents)
+	if err != nil {
+		return nil, response, err
+	}
+
+	if response.StatusCode != 200 {
+		return nil, response, &simpleError{fmt.Sprintf("Listing agents returned %s", response.Status)}
+	}
+
+	// Bamboo can only filter on online agents, offline agents and types are filtered here
+	matching := []*Agent{}
+	for _, agent := range agents {
+		if options.matches(agent) {
+			matching = append(matching, agent)
+		}
+	}
+	return matching, response, nil
+}
+
+// GetAgent returns the given agent together with the build or deployment it is running
+func (a *AgentService) GetAgent(id int64) (*Agent, *http.Response, error) {
+	request, err := a.client.NewRequest(http.MethodGet, fmt.Sprintf("agent/%d", id), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	agent := Agent{}
+	response, err := a.client.Do(request, &agent)
+	if err != nil {
+		return nil, response, err
+	}
+
+	if response.StatusCode == 404 {
+		return nil, response, ErrNotFound
+	}
+
+	if response.StatusCode != 200 {
+		return nil, response, &simpleError{fmt.Sprintf("Getting agent %d returned %s", id, response.Status)}
+	}
+
+	return &agent, response, nil
+}
+
+// DeleteAgent removes the given offline remote agent from the server
+func (a *AgentService) DeleteAgent(id int64) (*http.Response, error) {
+	request, err := a.client.NewRequest(http.MethodDelete, fmt.Sprintf("agent/%d", id), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := a.client.Do(request, nil)
+	if err != nil {
+		return response, err
+	}
+
+	if response.StatusCode != 200 && response.StatusCode != 204 {
+		return response, &simpleError{fmt.Sprintf("Deleting agent %d returned %s", id, response.Status)}
+	}
+
+	return response, nil
+}
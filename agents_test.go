@@ -0,0 +1,120 @@
+package bamboo_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	bamboo "github.com/sukhyun/go-bamboo"
+)
+
+func TestListAgents(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(listAgentsStub))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	agents, _, err := client.Agents.ListAgents(nil)
+	assert.NoError(t, err)
+	assert.Len(t, agents, 3)
+
+	online, _, err := client.Agents.ListAgents(&bamboo.AgentListOptions{Online: true})
+	assert.NoError(t, err)
+	assert.Len(t, online, 2)
+
+	offline, _, err := client.Agents.ListAgents(&bamboo.AgentListOptions{Offline: true})
+	assert.NoError(t, err)
+	if assert.Len(t, offline, 1) {
+		assert.Equal(t, "remote-agent-2", offline[0].Name)
+	}
+
+	_, _, err = client.Agents.ListAgents(&bamboo.AgentListOptions{Online: true, Offline: true})
+	assert.Error(t, err)
+}
+
+func listAgentsStub(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/rest/api/latest/agent" {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	if r.URL.Query().Get("online") == "true" {
+		w.Write([]byte(`[{"id":1,"name":"Default Agent","type":"LOCAL","active":true,"enabled":true,"busy":false},
+			{"id":131073,"name":"remote-agent-1","type":"REMOTE","active":true,"enabled":true,"busy":true}]`))
+		return
+	}
+	w.Write([]byte(`[{"id":1,"name":"Default Agent","type":"LOCAL","active":true,"enabled":true,"busy":false},
+		{"id":131073,"name":"remote-agent-1","type":"REMOTE","active":true,"enabled":true,"busy":true},
+		{"id":131074,"name":"remote-agent-2","type":"REMOTE","active":false,"enabled":true,"busy":false}]`))
+}
+
+func TestGetAgent(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/rest/api/latest/agent/131073":
+			w.Write([]byte(`{"id":131073,"name":"remote-agent-1","type":"REMOTE","active":true,"enabled":true,"busy":true,
+				"currentJob":{"buildResultKey":"CORE-TEST-JOB1-7","name":"Core - Test - Default Job","startedTime":"2020-01-02T10:00:00.000+01:00"}}`))
+		case "/rest/api/latest/agent/131075":
+			w.Write([]byte(`{"id":131075,"name":"deploy-agent","type":"REMOTE","active":true,"enabled":true,"busy":true,
+				"currentJob":{"deploymentResultId":2049,"name":"Core deployment - Production"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	agent, _, err := client.Agents.GetAgent(131073)
+	assert.NoError(t, err)
+	assert.Equal(t, "CORE-TEST-JOB1-7", agent.CurrentJob.BuildResultKey)
+	assert.False(t, agent.CurrentJob.IsDeployment())
+
+	agent, _, err = client.Agents.GetAgent(131075)
+	assert.NoError(t, err)
+	assert.True(t, agent.CurrentJob.IsDeployment())
+
+	_, _, err = client.Agents.GetAgent(131076)
+	assert.Equal(t, bamboo.ErrNotFound, err)
+}
+
+func TestDeleteAgent(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/rest/api/latest/agent/131074" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	_, err := client.Agents.DeleteAgent(131074)
+	assert.NoError(t, err)
+
+	_, err = client.Agents.DeleteAgent(131075)
+	assert.Error(t, err)
+}
+
+func TestListOnlineRemoteAgents(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(listAgentsStub))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	agents, _, err := client.Agents.ListAgents(&bamboo.AgentListOptions{Online: true, Type: bamboo.RemoteAgentType})
+	assert.NoError(t, err)
+	if assert.Len(t, agents, 1) {
+		assert.Equal(t, "remote-agent-1", agents[0].Name)
+	}
+
+	agents, _, err = client.Agents.ListAgents(&bamboo.AgentListOptions{Type: bamboo.ElasticAgentType})
+	assert.NoError(t, err)
+	assert.Empty(t, agents)
+}
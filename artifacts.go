@@ -0,0 +1,126 @@
+package bamboo
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+)
+
+// Artifacts is the collection of artifacts of a result
+type Artifacts struct {
+	*CollectionMetadata
+	ArtifactList []*Artifact `json:"artifact"`
+}
+
+// Artifact is a single artifact produced by a build
+// - Link:           Link to download the artifact from
+// - ProducerJobKey: Key of the job which produced the artifact
+// - Shared:         True if the artifact can be consumed by other jobs and deployments
+// - Size:           Size of the artifact in bytes
+type Artifact struct {
+	Name           string `json:"name"`
+	Link           *Link  `json:"link"`
+	ProducerJobKey string `json:"producerJobKey"`
+	Shared         bool   `json:"shared"`
+	Size           int64  `json:"size"`
+}
+
+type artifactsResponse struct {
+	Artifacts *Artifacts `json:"artifacts"`
+}
+
+// ListArtifacts returns the artifacts produced by the given result
+func (r *ResultService) ListArtifacts(resultKey string) ([]*Artifact, *http.Response, error) {
+	if emptyStrings(resultKey) {
+		return nil, nil, &simpleError{"Result key cannot be empty"}
+	}
+
+	request, err := r.client.NewRequest(http.MethodGet, fmt.Sprintf("%s/%s.json", resultsBase, resultKey), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	values := request.URL.Query()
+	values.Set("expand", "artifacts")
+	request.URL.RawQuery = values.Encode()
+
+	result := artifactsResponse{}
+	response, err := r.client.Do(request, &result)
+	if err != nil {
+		return nil, response, err
+	}
+
+	if response.StatusCode != 200 {
+		return nil, response, &simpleError{fmt.Sprintf("Listing artifacts of %s returned %s", resultKey, response.Status)}
+	}
+
+	if result.Artifacts == nil {
+		return []*Artifact{}, response, nil
+	}
+	return result.Artifacts.ArtifactList, response, nil
+}
+
+// DownloadArtifact streams the artifact with the given name of the given result to w.
+// Artifacts which are directories can't be downloaded as a single file.
+func (r *ResultService) DownloadArtifact(resultKey, artifactName string, w io.Writer) (*http.Response, error) {
+	return r.ResumeArtifactDownload(resultKey, artifactName, 0, w)
+}
+
+// ResumeArtifactDownload streams the artifact with the given name of the given result to w,
+// starting at the given byte offset. Used to resume an interrupted download of a large artifact.
+// The timeout of the client's http.Client isn't applied to the download, as it would cut off
+// large artifacts partway through. Dial and TLS timeouts of its transport still apply.
+func (r *ResultService) ResumeArtifactDownload(resultKey, artifactName string, offset int64, w io.Writer) (*http.Response, error) {
+	if emptyStrings(resultKey, artifactName) || w == nil {
+		return nil, &simpleError{"Result key, artifact name and writer cannot be empty"}
+	}
+
+	artifacts, response, err := r.ListArtifacts(resultKey)
+	if err != nil {
+		return response, err
+	}
+
+	var artifact *Artifact
+	for _, a := range artifacts {
+		if a.Name == artifactName && a.Link != nil {
+			artifact = a
+			break
+		}
+	}
+	if artifact == nil {
+		return response, ErrNotFound
+	}
+
+	request, err := r.client.NewRequest(http.MethodGet, artifact.Link.HREF, nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Accept", "*/*")
+	if offset > 0 {
+		request.Header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+	}
+
+	// http.Client.Timeout includes reading the body, which can take long for a large artifact
+	streaming := *r.client.client
+	streaming.Timeout = 0
+	response, err = streaming.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	switch response.StatusCode {
+	case http.StatusPartialContent:
+	case http.StatusOK:
+		// The server ignored the range and sent the whole artifact, skip what was already downloaded
+		if _, err := io.CopyN(ioutil.Discard, response.Body, offset); err != nil {
+			return response, err
+		}
+	default:
+		return response, &simpleError{fmt.Sprintf("Downloading artifact %s of %s returned %s", artifactName, resultKey, response.Status)}
+	}
+
+	_, err = io.Copy(w, response.Body)
+	return response, err
+}
@@ -0,0 +1,143 @@
+package bamboo_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	bamboo "github.com/sukhyun/go-bamboo"
+)
+
+func TestListArtifacts(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(listArtifactsStub))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	artifacts, response, err := client.Results.ListArtifacts("CORE-TEST-7")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	assert.Equal(t, 2, len(artifacts))
+	assert.True(t, artifacts[0].Shared)
+	assert.Equal(t, int64(1024), artifacts[0].Size)
+}
+
+func listArtifactsStub(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/rest/api/latest/result/CORE-TEST-7.json" || r.URL.Query().Get("expand") != "artifacts" {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	resp := map[string]*bamboo.Artifacts{
+		"artifacts": &bamboo.Artifacts{ArtifactList: []*bamboo.Artifact{
+			&bamboo.Artifact{Name: "app.jar", Shared: true, Size: 1024, ProducerJobKey: "CORE-TEST-JOB1",
+				Link: &bamboo.Link{HREF: "/browse/CORE-TEST-7/artifact/shared/app.jar/app.jar"}},
+			&bamboo.Artifact{Name: "reports", Size: 10, ProducerJobKey: "CORE-TEST-JOB1"},
+		}},
+	}
+
+	bytes, err := json.Marshal(resp)
+	if err != nil {
+		panic(err)
+	}
+
+	w.Write(bytes)
+}
+
+const testArtifact = "0123456789abcdef"
+
+func TestDownloadArtifact(t *testing.T) {
+	var testCases = []struct {
+		honorRange bool
+		offset     int64
+		expected   string
+	}{
+		{true, 0, testArtifact},
+		{true, 10, testArtifact[10:]},
+		{false, 10, testArtifact[10:]},
+	}
+
+	for _, c := range testCases {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			downloadArtifactStub(w, r, c.honorRange)
+		}))
+
+		client := bamboo.NewSimpleClient(nil, "", "", "")
+		client.SetURL(ts.URL)
+
+		buf := &bytes.Buffer{}
+		_, err := client.Results.ResumeArtifactDownload("CORE-TEST-7", "app.jar", c.offset, buf)
+		assert.NoError(t, err)
+		assert.Equal(t, c.expected, buf.String())
+		ts.Close()
+	}
+}
+
+func TestDownloadSlowArtifact(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/browse/CORE-TEST-7/artifact/shared/app.jar/app.jar" {
+			downloadArtifactStub(w, r, true)
+			return
+		}
+
+		// Take longer than the client timeout to send the whole artifact
+		w.Write([]byte(testArtifact[:10]))
+		w.(http.Flusher).Flush()
+		time.Sleep(100 * time.Millisecond)
+		w.Write([]byte(testArtifact[10:]))
+	}))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(&http.Client{Timeout: 50 * time.Millisecond}, "", "", "")
+	client.SetURL(ts.URL)
+
+	buf := &bytes.Buffer{}
+	_, err := client.Results.DownloadArtifact("CORE-TEST-7", "app.jar", buf)
+	assert.NoError(t, err)
+	assert.Equal(t, testArtifact, buf.String())
+}
+
+func TestDownloadMissingArtifact(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		downloadArtifactStub(w, r, true)
+	}))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	_, err := client.Results.DownloadArtifact("CORE-TEST-7", "missing", &bytes.Buffer{})
+	assert.Equal(t, bamboo.ErrNotFound, err)
+}
+
+func downloadArtifactStub(w http.ResponseWriter, r *http.Request, honorRange bool) {
+	const artifactPath = "/browse/CORE-TEST-7/artifact/shared/app.jar/app.jar"
+
+	switch r.URL.Path {
+	case "/rest/api/latest/result/CORE-TEST-7.json":
+		resp := map[string]*bamboo.Artifacts{
+			"artifacts": &bamboo.Artifacts{ArtifactList: []*bamboo.Artifact{
+				&bamboo.Artifact{Name: "app.jar", Shared: true, Size: int64(len(testArtifact)), Link: &bamboo.Link{HREF: artifactPath}},
+			}},
+		}
+		bytes, _ := json.Marshal(resp)
+		w.Write(bytes)
+	case artifactPath:
+		var offset int
+		fmt.Sscanf(r.Header.Get("Range"), "bytes=%d-", &offset)
+		if honorRange && offset > 0 {
+			w.WriteHeader(http.StatusPartialContent)
+			w.Write([]byte(testArtifact[offset:]))
+			return
+		}
+		w.Write([]byte(testArtifact))
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
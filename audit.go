@@ -0,0 +1,178 @@
+package bamboo
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// AuditService handles communication with the global audit log
+type AuditService service
+
+// AuditEntry is a single configuration change recorded in the Bamboo audit log
+// - Username: User who made the change
+// - Date:     When the change was made, in milliseconds since the epoch
+// - Entity:   Key of the plan, job or other entity which was changed
+// - Field:    The changed configuration field
+type AuditEntry struct {
+	Username          string `json:"username"`
+	Date              int64  `json:"date"`
+	Entity            string `json:"entity,omitempty"`
+	Field             string `json:"field"`
+	OldValue          string `json:"oldValue"`
+	NewValue          string `json:"newValue"`
+	ChangeDescription string `json:"changeDescription,omitempty"`
+}
+
+// Time returns the time the change was made
+func (a *AuditEntry) Time() time.Time {
+	return time.Unix(0, a.Date*int64(time.Millisecond))
+}
+
+// AuditLogResult holds a page of audit log entries
+type AuditLogResult struct {
+	*Index
+	Entries []*AuditEntry `json:"results"`
+}
+
+// PlanAuditLog returns the audit log entries for the given plan. A nil page returns the server's default page.
+func (p *PlanService) PlanAuditLog(planKey string, page *Pagination) ([]*AuditEntry, *http.Response, error) {
+	var u string
+	if !emptyStrings(planKey) {
+		u = fmt.Sprintf("plan/%s/audit", planKey)
+	} else {
+		return nil, nil, &simpleError{"Plan key cannot be empty"}
+	}
+
+	request, err := p.client.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	values := request.URL.Query()
+	page.setQuery(values)
+	request.URL.RawQuery = values.Encode()
+
+	auditLog := AuditLogResult{}
+	response, err := p.client.Do(request, &auditLog)
+	if err != nil {
+		return nil, response, err
+	}
+
+	if response.StatusCode != 200 {
+		return nil, response, &simpleError{fmt.Sprintf("Getting the audit log of %s returned %s", planKey, response.Status)}
+	}
+
+	return auditLog.Entries, response, nil
+}
+
+// AuditListOptions specifies the optional parameters
+// for listing the global audit log
+// - Page:     Only return the given page, all entries are returned when nil
+// - From:     Only return changes made at or after this time
+// - To:       Only return changes made before this time
+// - Username: Only return changes made by the given user
+// - Entity:   Only return changes to the given plan, job or other entity
+type AuditListOptions struct {
+	Page     *Pagination
+	From     time.Time
+	To       time.Time
+	Username string
+	Entity   string
+}
+
+func (o *AuditListOptions) matches(entry *AuditEntry) bool {
+	if o == nil {
+		return true
+	}
+	switch {
+	case !o.From.IsZero() && entry.Time().Before(o.From):
+		return false
+	case !o.To.IsZero() && !entry.Time().Before(o.To):
+		return false
+	case o.Username != "" && entry.Username != o.Username:
+		return false
+	case o.Entity != "" && entry.Entity != o.Entity:
+		return false
+	}
+	return true
+}
+
+// List returns the entries of the global audit log matching the given options
+func (a *AuditService) List(options *AuditListOptions) ([]*AuditEntry, *http.Response, error) {
+	if options != nil && !options.From.IsZero() && !options.To.IsZero() && !options.From.Before(options.To) {
+		return nil, nil, &simpleError{"Audit log start time must be before its end time"}
+	}
+
+	if options != nil && options.Page != nil {
+		auditLog, response, err := a.listPage(options, *options.Page)
+		if err != nil {
+			return nil, response, err
+		}
+		return a.filter(options, auditLog.Entries), response, nil
+	}
+
+	entries := []*AuditEntry{}
+	next := Pagination{Limit: defaultPageSize}
+	for {
+		auditLog, response, err := a.listPage(options, next)
+		if err != nil {
+			return nil, response, err
+		}
+
+		entries = append(entries, a.filter(options, auditLog.Entries)...)
+		next.Start += len(auditLog.Entries)
+		if len(auditLog.Entries) == 0 || !auditLog.hasMore(len(auditLog.Entries)) {
+			return entries, response, nil
+		}
+	}
+}
+
+// filter drops the entries Bamboo returned which don't match the given options
+func (a *AuditService) filter(options *AuditListOptions, entries []*AuditEntry) []*AuditEntry {
+	matching := []*AuditEntry{}
+	for _, entry := range entries {
+		if options.matches(entry) {
+			matching = append(matching, entry)
+		}
+	}
+	return matching
+}
+
+func (a *AuditService) listPage(options *AuditListOptions, page Pagination) (*AuditLogResult, *http.Response, error) {
+	request, err := a.client.NewRequest(http.MethodGet, "audit", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	values := request.URL.Query()
+	page.setQuery(values)
+	if options != nil {
+		if !options.From.IsZero() {
+			values.Set("fromDate", strconv.FormatInt(options.From.UnixNano()/int64(time.Millisecond), 10))
+		}
+		if !options.To.IsZero() {
+			values.Set("toDate", strconv.FormatInt(options.To.UnixNano()/int64(time.Millisecond), 10))
+		}
+		if options.Username != "" {
+			values.Set("username", options.Username)
+		}
+		if options.Entity != "" {
+			values.Set("entity", options.Entity)
+		}
+	}
+	request.URL.RawQuery = values.Encode()
+
+	auditLog := AuditLogResult{}
+	response, err := a.client.Do(request, &auditLog)
+	if err != nil {
+		return nil, response, err
+	}
+
+	if response.StatusCode != 200 {
+		return nil, response, &simpleError{fmt.Sprintf("Getting the audit log returned %s", response.Status)}
+	}
+
+	return &auditLog, response, nil
+}
@@ -0,0 +1,93 @@
+package bamboo_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	bamboo "github.com/sukhyun/go-bamboo"
+)
+
+func TestPlanAuditLog(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(planAuditLogStub))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	entries, response, err := client.Plans.PlanAuditLog("CORE-TEST", &bamboo.Pagination{Limit: 50})
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	assert.Equal(t, 1, len(entries))
+	assert.Equal(t, "admin", entries[0].Username)
+	assert.Equal(t, time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), entries[0].Time().UTC())
+}
+
+func planAuditLogStub(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/rest/api/latest/plan/CORE-TEST/audit" || r.URL.Query().Get("limit") != "50" {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	resp := bamboo.AuditLogResult{
+		Entries: []*bamboo.AuditEntry{
+			&bamboo.AuditEntry{Username: "admin", Date: 1577836800000, Field: "Plan name", OldValue: "a", NewValue: "b"},
+		},
+	}
+
+	bytes, err := json.Marshal(resp)
+	if err != nil {
+		panic(err)
+	}
+
+	w.Write(bytes)
+}
+
+func TestAuditList(t *testing.T) {
+	from := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2020, 2, 1, 0, 0, 0, 0, time.UTC)
+	pages := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if r.URL.Path != "/rest/api/latest/audit" || query.Get("fromDate") != "1577836800000" || query.Get("username") != "admin" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		pages++
+
+		resp := bamboo.AuditLogResult{Index: &bamboo.Index{Limit: 100}}
+		switch query.Get("start") {
+		case "":
+			for i := 0; i < 100; i++ {
+				resp.Entries = append(resp.Entries, &bamboo.AuditEntry{Username: "admin", Date: 1577836800000 + int64(i), Entity: "CORE-TEST"})
+			}
+		case "100":
+			resp.Entries = []*bamboo.AuditEntry{
+				&bamboo.AuditEntry{Username: "admin", Date: 1577836900000, Entity: "CORE-TEST"},
+				// Outside the requested range, dropped by the client
+				&bamboo.AuditEntry{Username: "admin", Date: 1580515200000, Entity: "CORE-TEST"},
+			}
+		}
+
+		bytes, err := json.Marshal(resp)
+		if err != nil {
+			panic(err)
+		}
+		w.Write(bytes)
+	}))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	entries, _, err := client.Audit.List(&bamboo.AuditListOptions{From: from, To: to, Username: "admin"})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, pages)
+	assert.Len(t, entries, 101)
+
+	_, _, err = client.Audit.List(&bamboo.AuditListOptions{From: to, To: from})
+	assert.Error(t, err)
+}
@@ -0,0 +1,123 @@
+package bamboo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// StaleBranchOptions specifies which plan branches the CleanupStaleBranches method treats as stale
+// - LastBuiltBefore:  Branches whose latest build completed before this time are stale. Ignored if zero.
+// - DeletedVCSBranch: Branches which don't build any of the plan's VCS branches are stale
+// - DryRun:           Only report the stale branches without deleting them
+type StaleBranchOptions struct {
+	LastBuiltBefore  time.Time
+	DeletedVCSBranch bool
+	DryRun           bool
+}
+
+// CleanupStaleBranches deletes the plan branches of the given plan which are stale according to
+// the options and returns them. Branches which were never built or whose latest build is still
+// running are not considered stale by date. With DeletedVCSBranch set, an empty VCS branch list is
+// refused unless DryRun is set, since it would make every plan branch stale.
+func (pb *PlanBranchService) CleanupStaleBranches(planKey string, options StaleBranchOptions) ([]*Branch, *http.Response, error) {
+	branches, response, err := pb.ListPlanBranches(planKey)
+	if err != nil {
+		return nil, response, err
+	}
+
+	var vcsBranches []string
+	if options.DeletedVCSBranch {
+		vcsBranches, response, err = pb.ListVCSBranches(planKey)
+		if err != nil {
+			return nil, response, err
+		}
+
+		// An empty list means the repository couldn't be listed, every branch would be deleted
+		if len(vcsBranches) == 0 && !options.DryRun {
+			return nil, response, &simpleError{fmt.Sprintf("Listing VCS branches for %s returned no branches, refusing to delete its plan branches", planKey)}
+		}
+	}
+
+	stale := []*Branch{}
+	for _, branch := range branches {
+		if branch.PlanKey == nil {
+			continue
+		}
+
+		isStale := options.DeletedVCSBranch && !buildsAnyVCSBranch(branch, vcsBranches)
+		if !isStale && !options.LastBuiltBefore.IsZero() {
+			isStale, response, err = pb.lastBuiltBefore(branch.Key, options.LastBuiltBefore)
+			if err != nil {
+				return stale, response, err
+			}
+		}
+
+		if isStale {
+			stale = append(stale, branch)
+		}
+	}
+
+	if options.DryRun {
+		return stale, response, nil
+	}
+
+	plans := (*PlanService)(pb)
+	for i, branch := range stale {
+		response, err = plans.DeletePlan(branch.Key)
+		if err != nil {
+			return stale[:i], response, err
+		}
+	}
+	return stale, response, nil
+}
+
+// CleanupAllStaleBranches runs CleanupStaleBranches for every plan on the server and returns
+// the stale branches of all plans
+func (pb *PlanBranchService) CleanupAllStaleBranches(options StaleBranchOptions) ([]*Branch, *http.Response, error) {
+	planKeys, response, err := (*PlanService)(pb).ListPlanKeys()
+	if err != nil {
+		return nil, response, err
+	}
+
+	stale := []*Branch{}
+	for _, planKey := range planKeys {
+		branches, resp, err := pb.CleanupStaleBranches(planKey, options)
+		stale = append(stale, branches...)
+		if err != nil {
+			return stale, resp, err
+		}
+		response = resp
+	}
+	return stale, response, nil
+}
+
+// buildsAnyVCSBranch reports whether the plan branch builds one of the given VCS branches,
+// matched the same way as FindBranchByVCSBranch
+func buildsAnyVCSBranch(branch *Branch, vcsBranches []string) bool {
+	for _, vcsBranch := range vcsBranches {
+		if branch.buildsVCSBranch(vcsBranch) {
+			return true
+		}
+	}
+	return false
+}
+
+// lastBuiltBefore reports whether the latest build of the given plan completed before the given time.
+// Plans which were never built or are building right now are not reported.
+func (pb *PlanBranchService) lastBuiltBefore(planKey string, before time.Time) (bool, *http.Response, error) {
+	result, response, err := (*ResultService)(pb).resultSummary(context.Background(), planKey+"-latest")
+	if response != nil && response.StatusCode == 404 {
+		return false, response, nil
+	}
+	if err != nil {
+		return false, response, err
+	}
+
+	completed := result.CompletedTime()
+	if completed.IsZero() {
+		return false, response, nil
+	}
+	return completed.Before(before), response, nil
+}
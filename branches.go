@@ -3,6 +3,8 @@ package bamboo
 import (
 	"fmt"
 	"net/http"
+	"net/url"
+	"strings"
 )
 
 // PlanBranchService is a derivative of the plan service to handle
@@ -131,3 +133,183 @@ func (pb *PlanBranchService) BranchInfo(planKey, branchName string) (*Branch, *h
 
 	return &branch, response, nil
 }
+
+// BranchCreateManually only creates plan branches when requested by a user
+const BranchCreateManually string = "MANUALLY"
+
+// BranchCreateForNewBranches creates plan branches for new VCS branches matching the pattern
+const BranchCreateForNewBranches string = "FOR_NEW_BRANCHES"
+
+// BranchCreateForPullRequests creates plan branches for new pull requests
+const BranchCreateForPullRequests string = "FOR_PULL_REQUEST"
+
+// BranchNotifyNone disables notifications for plan branches
+const BranchNotifyNone string = "NONE"
+
+// BranchNotifyCommitters notifies the committers of a plan branch
+const BranchNotifyCommitters string = "NOTIFY_COMMITTERS"
+
+// BranchNotifyInherit uses the same notifications as the master plan
+const BranchNotifyInherit string = "INHERIT"
+
+// BranchTriggerInherited uses the same triggers as the master plan
+const BranchTriggerInherited string = "INHERITED"
+
+// BranchTriggerManual only runs plan branch builds when triggered by a user
+const BranchTriggerManual string = "MANUAL"
+
+// BranchManagement holds the automatic branch management configuration of a plan
+// - CreationStrategy:     When plan branches are created, e.g. BranchCreateForNewBranches
+// - MatchingPattern:      Regular expression VCS branch names must match for a plan branch to be created
+// - NotificationStrategy: Who is notified about plan branch builds, e.g. BranchNotifyCommitters
+// - TriggerStrategy:      How plan branch builds are triggered, e.g. BranchTriggerInherited
+type BranchManagement struct {
+	CreationStrategy     string `json:"creationStrategy,omitempty"`
+	MatchingPattern      string `json:"matchingPattern,omitempty"`
+	NotificationStrategy string `json:"notificationStrategy,omitempty"`
+	TriggerStrategy      string `json:"triggerStrategy,omitempty"`
+}
+
+// BranchManagement returns the automatic branch management configuration of the given plan
+func (pb *PlanBranchService) BranchManagement(planKey string) (*BranchManagement, *http.Response, error) {
+	var u string
+	if !emptyStrings(planKey) {
+		u = fmt.Sprintf("plan/%s/branchManagement.json", planKey)
+	} else {
+		return nil, nil, &simpleError{"Plan key cannot be empty"}
+	}
+
+	request, err := pb.client.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	management := BranchManagement{}
+	response, err := pb.client.Do(request, &management)
+	if err != nil {
+		return nil, response, err
+	}
+
+	if response.StatusCode != 200 {
+		return nil, response, &simpleError{fmt.Sprintf("Getting branch management for %s returned %s", planKey, response.Status)}
+	}
+
+	return &management, response, nil
+}
+
+// UpdateBranchManagement replaces the automatic branch management configuration of the given plan
+func (pb *PlanBranchService) UpdateBranchManagement(planKey string, management *BranchManagement) (*http.Response, error) {
+	var u string
+	if !emptyStrings(planKey) && management != nil {
+		u = fmt.Sprintf("plan/%s/branchManagement.json", planKey)
+	} else {
+		return nil, &simpleError{"Plan key and/or branch management cannot be empty"}
+	}
+
+	request, err := pb.client.NewRequest(http.MethodPut, u, management)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := pb.client.Do(request, nil)
+	if err != nil {
+		return response, err
+	}
+
+	if response.StatusCode != 200 && response.StatusCode != 204 {
+		return response, &simpleError{fmt.Sprintf("Updating branch management for %s returned %s", planKey, response.Status)}
+	}
+
+	return response, nil
+}
+
+// planBranchNameMaxLength is the longest plan branch name Bamboo will create
+const planBranchNameMaxLength = 255
+
+// PlanBranchName returns the plan branch name Bamboo derives from the given VCS branch name.
+// Slashes are replaced with dashes and the name is truncated to the maximum allowed length.
+func PlanBranchName(vcsBranch string) string {
+	name := strings.Replace(vcsBranch, "/", "-", -1)
+	if len(name) > planBranchNameMaxLength {
+		name = name[:planBranchNameMaxLength]
+	}
+	return name
+}
+
+// FindBranchByVCSBranch returns the plan key of the plan branch building the given VCS branch.
+// Returns ErrNotFound if the plan has no branch for the VCS branch.
+func (p *PlanService) FindBranchByVCSBranch(planKey, vcsBranch string) (string, *http.Response, error) {
+	branch, response, err := p.findBranch(planKey, vcsBranch)
+	if err != nil {
+		return "", response, err
+	}
+	return branch.Key, response, nil
+}
+
+// findBranch returns the plan branch building the given VCS branch, or ErrNotFound
+func (p *PlanService) findBranch(planKey, vcsBranch string) (*Branch, *http.Response, error) {
+	if emptyStrings(planKey, vcsBranch) {
+		return nil, nil, &simpleError{"Plan key and/or VCS branch cannot be empty"}
+	}
+	name := PlanBranchName(vcsBranch)
+
+	request, err := p.client.NewRequest(http.MethodGet, fmt.Sprintf("plan/%s/branch/%s.json", planKey, url.PathEscape(name)), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	values := request.URL.Query()
+	values.Set("vcsBranch", vcsBranch)
+	request.URL.RawQuery = values.Encode()
+
+	branch := Branch{}
+	response, err := p.client.Do(request, &branch)
+	if err != nil {
+		return nil, response, err
+	}
+
+	switch {
+	case response.StatusCode == 200 && branch.PlanKey != nil:
+		return &branch, response, nil
+	case response.StatusCode != 200 && response.StatusCode != 404:
+		return nil, response, &simpleError{fmt.Sprintf("Finding branch %s of %s returned %s", vcsBranch, planKey, response.Status)}
+	}
+
+	// The branch may have been renamed after creation, fall back to scanning the plan branches
+	planBranches, response, err := (*PlanBranchService)(p).ListPlanBranches(planKey)
+	if err != nil {
+		return nil, response, err
+	}
+
+	for _, b := range planBranches {
+		if b.buildsVCSBranch(vcsBranch) && b.PlanKey != nil {
+			return b, response, nil
+		}
+	}
+	return nil, response, ErrNotFound
+}
+
+// buildsVCSBranch reports whether the plan branch is named after the given VCS branch,
+// either as Bamboo derives it or, for renamed branches, by the raw VCS branch name
+func (b *Branch) buildsVCSBranch(vcsBranch string) bool {
+	return b.ShortName == PlanBranchName(vcsBranch) || b.Name == vcsBranch
+}
+
+// EnsurePlanBranch returns the plan branch building the given VCS branch, creating it first if it
+// doesn't exist. The plan branch is named after the VCS branch, see PlanBranchName.
+func (p *PlanService) EnsurePlanBranch(planKey, vcsBranch string, options *PlanCreateBranchOptions) (*Branch, *http.Response, error) {
+	branch, response, err := p.findBranch(planKey, vcsBranch)
+	if err != ErrNotFound {
+		return branch, response, err
+	}
+
+	createOptions := PlanCreateBranchOptions{}
+	if options != nil {
+		createOptions = *options
+	}
+	if createOptions.VCSBranch == "" {
+		createOptions.VCSBranch = vcsBranch
+	}
+
+	return p.CreatePlanBranch(planKey, url.PathEscape(PlanBranchName(vcsBranch)), &createOptions)
+}
@@ -0,0 +1,310 @@
+package bamboo_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	bamboo "github.com/sukhyun/go-bamboo"
+)
+
+func TestBranchManagement(t *testing.T) {
+	stored := &bamboo.BranchManagement{CreationStrategy: bamboo.BranchCreateManually}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		branchManagementStub(w, r, stored)
+	}))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	management := &bamboo.BranchManagement{
+		CreationStrategy:     bamboo.BranchCreateForNewBranches,
+		MatchingPattern:      "feature/.*",
+		NotificationStrategy: bamboo.BranchNotifyCommitters,
+		TriggerStrategy:      bamboo.BranchTriggerInherited,
+	}
+	_, err := client.Branches.UpdateBranchManagement("CORE-TEST", management)
+	assert.NoError(t, err)
+
+	result, response, err := client.Branches.BranchManagement("CORE-TEST")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	assert.Equal(t, management, result)
+}
+
+func branchManagementStub(w http.ResponseWriter, r *http.Request, stored *bamboo.BranchManagement) {
+	if r.URL.Path != "/rest/api/latest/plan/CORE-TEST/branchManagement.json" {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodPut:
+		json.NewDecoder(r.Body).Decode(stored)
+		w.WriteHeader(http.StatusNoContent)
+	case http.MethodGet:
+		bytes, err := json.Marshal(stored)
+		if err != nil {
+			panic(err)
+		}
+		w.Write(bytes)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func TestPlanBranchName(t *testing.T) {
+	assert.Equal(t, "feature-JIRA-1-fix", bamboo.PlanBranchName("feature/JIRA-1-fix"))
+	assert.Equal(t, 255, len(bamboo.PlanBranchName(strings.Repeat("a", 300))))
+}
+
+func TestFindBranchByVCSBranch(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(findBranchStub))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	key, _, err := client.Plans.FindBranchByVCSBranch("CORE-TEST", "feature/direct")
+	assert.NoError(t, err)
+	assert.Equal(t, "CORE-TEST1", key)
+
+	key, _, err = client.Plans.FindBranchByVCSBranch("CORE-TEST", "feature/renamed")
+	assert.NoError(t, err)
+	assert.Equal(t, "CORE-TEST2", key)
+
+	_, _, err = client.Plans.FindBranchByVCSBranch("CORE-TEST", "missing")
+	assert.Equal(t, bamboo.ErrNotFound, err)
+}
+
+func findBranchStub(w http.ResponseWriter, r *http.Request) {
+	var resp interface{}
+	switch r.URL.Path {
+	case "/rest/api/latest/plan/CORE-TEST/branch/feature-direct.json":
+		resp = bamboo.Branch{ShortName: "feature-direct", PlanKey: &bamboo.PlanKey{Key: "CORE-TEST1"}}
+	case "/rest/api/latest/plan/CORE-TEST/.json":
+		resp = bamboo.BranchesResponse{Branches: &bamboo.Branches{BranchList: []*bamboo.Branch{
+			&bamboo.Branch{ShortName: "renamed", Name: "feature/renamed", PlanKey: &bamboo.PlanKey{Key: "CORE-TEST2"}},
+		}}}
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	bytes, err := json.Marshal(resp)
+	if err != nil {
+		panic(err)
+	}
+
+	w.Write(bytes)
+}
+
+func TestEnsurePlanBranch(t *testing.T) {
+	created, creations := false, 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/rest/api/latest/plan/CORE-TEST/.json" {
+			w.Write([]byte(`{"branches":{"branch":[]}}`))
+			return
+		}
+		if r.URL.Path != "/rest/api/latest/plan/CORE-TEST/branch/feature-new.json" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		switch {
+		case r.Method == http.MethodPut:
+			if r.URL.Query().Get("vcsBranch") != "feature/new" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			created = true
+			creations++
+		case !created:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		bytes, _ := json.Marshal(bamboo.Branch{ShortName: "feature-new", PlanKey: &bamboo.PlanKey{Key: "CORE-TEST3"}})
+		w.Write(bytes)
+	}))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	branch, _, err := client.Plans.EnsurePlanBranch("CORE-TEST", "feature/new", nil)
+	assert.NoError(t, err)
+	assert.True(t, created)
+	assert.Equal(t, "CORE-TEST3", branch.Key)
+
+	// A second call must find the existing branch rather than fail on creation
+	branch, _, err = client.Plans.EnsurePlanBranch("CORE-TEST", "feature/new", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, creations)
+	assert.Equal(t, "CORE-TEST3", branch.Key)
+}
+
+func TestCleanupStaleBranches(t *testing.T) {
+	deleted := map[string]bool{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		staleBranchesStub(w, r, deleted)
+	}))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	options := bamboo.StaleBranchOptions{
+		LastBuiltBefore:  time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+		DeletedVCSBranch: true,
+		DryRun:           true,
+	}
+	stale, _, err := client.Branches.CleanupStaleBranches("CORE-TEST", options)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(stale))
+	assert.Empty(t, deleted)
+
+	options.DryRun = false
+	stale, _, err = client.Branches.CleanupStaleBranches("CORE-TEST", options)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(stale))
+	assert.Equal(t, map[string]bool{"CORE-TEST1": true, "CORE-TEST2": true}, deleted)
+}
+
+func TestCleanupStaleBranchesWithoutVCSBranches(t *testing.T) {
+	deleted := map[string]bool{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		staleBranchesStub(w, r, deleted)
+	}))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	options := bamboo.StaleBranchOptions{DeletedVCSBranch: true, DryRun: true}
+	stale, _, err := client.Branches.CleanupStaleBranches("CORE-EMPTY", options)
+	assert.NoError(t, err)
+	assert.Len(t, stale, 1)
+
+	options.DryRun = false
+	stale, _, err = client.Branches.CleanupStaleBranches("CORE-EMPTY", options)
+	assert.Error(t, err)
+	assert.Empty(t, stale)
+	assert.Empty(t, deleted)
+}
+
+func TestCleanupAllStaleBranches(t *testing.T) {
+	deleted := map[string]bool{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		staleBranchesStub(w, r, deleted)
+	}))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	options := bamboo.StaleBranchOptions{LastBuiltBefore: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), DryRun: true}
+	stale, _, err := client.Branches.CleanupAllStaleBranches(options)
+	assert.NoError(t, err)
+	if assert.Len(t, stale, 1) {
+		assert.Equal(t, "CORE-TEST2", stale[0].Key)
+	}
+	assert.Empty(t, deleted)
+}
+
+func staleBranchesStub(w http.ResponseWriter, r *http.Request, deleted map[string]bool) {
+	var resp interface{}
+	switch r.URL.Path {
+	case "/rest/api/latest/plan/CORE-TEST/.json":
+		resp = bamboo.BranchesResponse{Branches: &bamboo.Branches{BranchList: []*bamboo.Branch{
+			// VCS branch was deleted
+			&bamboo.Branch{ShortName: "feature-gone", PlanKey: &bamboo.PlanKey{Key: "CORE-TEST1"}},
+			// Not built since 2019
+			&bamboo.Branch{ShortName: "feature-old", PlanKey: &bamboo.PlanKey{Key: "CORE-TEST2"}},
+			// Built recently
+			&bamboo.Branch{ShortName: "feature-new", PlanKey: &bamboo.PlanKey{Key: "CORE-TEST3"}},
+			// Never built
+			&bamboo.Branch{ShortName: "feature-never", PlanKey: &bamboo.PlanKey{Key: "CORE-TEST4"}},
+			// First build still running
+			&bamboo.Branch{ShortName: "feature-building", PlanKey: &bamboo.PlanKey{Key: "CORE-TEST5"}},
+			// Renamed after creation, still building its VCS branch
+			&bamboo.Branch{ShortName: "release", Name: "release/2.x", PlanKey: &bamboo.PlanKey{Key: "CORE-TEST6"}},
+		}}}
+	case "/rest/api/latest/plan/CORE-EMPTY/.json":
+		resp = bamboo.BranchesResponse{Branches: &bamboo.Branches{BranchList: []*bamboo.Branch{
+			&bamboo.Branch{ShortName: "feature-live", PlanKey: &bamboo.PlanKey{Key: "CORE-EMPTY1"}},
+		}}}
+	case "/rest/api/latest/plan/CORE-EMPTY/vcsBranches.json":
+		resp = bamboo.BranchesResponse{Branches: &bamboo.Branches{}}
+	case "/rest/api/latest/plan/CORE-TEST/vcsBranches.json":
+		resp = bamboo.BranchesResponse{Branches: &bamboo.Branches{BranchList: []*bamboo.Branch{
+			&bamboo.Branch{Name: "feature/old"},
+			&bamboo.Branch{Name: "feature/new"},
+			&bamboo.Branch{Name: "feature/never"},
+			&bamboo.Branch{Name: "feature/building"},
+			&bamboo.Branch{Name: "release/2.x"},
+		}}}
+	case "/rest/api/latest/plan.json":
+		resp = bamboo.PlanResponse{Plans: &bamboo.Plans{PlanList: []*bamboo.Plan{&bamboo.Plan{Key: "CORE-TEST"}}}}
+	case "/rest/api/latest/result/CORE-TEST2-latest.json":
+		resp = bamboo.Result{BuildCompletedTime: "2019-06-01T10:00:00.000+02:00"}
+	case "/rest/api/latest/result/CORE-TEST3-latest.json":
+		resp = bamboo.Result{BuildCompletedTime: "2020-06-01T10:00:00.000+02:00"}
+	case "/rest/api/latest/result/CORE-TEST5-latest.json":
+		resp = bamboo.Result{LifeCycleState: bamboo.InProgressLifeCycleState}
+	case "/rest/api/latest/plan/CORE-TEST1", "/rest/api/latest/plan/CORE-TEST2":
+		if r.Method != http.MethodDelete {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		deleted[strings.TrimPrefix(r.URL.Path, "/rest/api/latest/plan/")] = true
+		w.WriteHeader(http.StatusNoContent)
+		return
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	bytes, err := json.Marshal(resp)
+	if err != nil {
+		panic(err)
+	}
+
+	w.Write(bytes)
+}
+
+func TestCreatePlanBranch(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(createPlanBranchStub))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	options := &bamboo.PlanCreateBranchOptions{VCSBranch: "feature/new", Enabled: true, CleanupEnabled: true}
+	branch, response, err := client.Plans.CreatePlanBranch("CORE-TEST", "feature-new", options)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	assert.Equal(t, "CORE-TEST5", branch.Key)
+	assert.True(t, branch.Enabled)
+}
+
+func createPlanBranchStub(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	if r.Method != http.MethodPut || r.URL.Path != "/rest/api/latest/plan/CORE-TEST/branch/feature-new.json" ||
+		q.Get("vcsBranch") != "feature/new" || q.Get("enabled") != "true" || q.Get("cleanupEnabled") != "true" {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	bytes, err := json.Marshal(bamboo.Branch{ShortName: "feature-new", Enabled: true, PlanKey: &bamboo.PlanKey{Key: "CORE-TEST5"}})
+	if err != nil {
+		panic(err)
+	}
+
+	w.Write(bytes)
+}
@@ -0,0 +1,148 @@
+package bamboo
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// GetBuildLog writes the build log of the given job result, e.g. "PROJ-PLAN-JOB1-123", to w
+func (r *ResultService) GetBuildLog(jobResultKey string, w io.Writer) (*http.Response, error) {
+	jobKey, _, ok := splitResultKey(jobResultKey)
+	if !ok || w == nil {
+		return nil, &simpleError{fmt.Sprintf("%q is not a job result key or the writer is nil", jobResultKey)}
+	}
+
+	request, err := r.client.NewRequest(http.MethodGet, buildLogURL(jobKey, jobResultKey), nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Accept", "text/plain")
+
+	response, err := r.client.Do(request, w)
+	if err != nil {
+		return response, err
+	}
+
+	if response.StatusCode != 200 {
+		return response, &simpleError{fmt.Sprintf("Downloading the build log of %s returned %s", jobResultKey, response.Status)}
+	}
+
+	return response, nil
+}
+
+// FollowBuildLog polls the build log of the given job result every interval and sends each new
+// line on the returned channel until the job finishes or ctx is cancelled. The line channel is
+// closed once following stops and any error which stopped it is sent on the error channel.
+// The interval must be positive.
+func (r *ResultService) FollowBuildLog(ctx context.Context, jobResultKey string, interval time.Duration) (<-chan string, <-chan error) {
+	lines := make(chan string)
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(lines)
+		if err := r.followBuildLog(ctx, jobResultKey, interval, lines); err != nil {
+			errs <- err
+		}
+		close(errs)
+	}()
+	return lines, errs
+}
+
+func (r *ResultService) followBuildLog(ctx context.Context, jobResultKey string, interval time.Duration, lines chan<- string) error {
+	jobKey, _, ok := splitResultKey(jobResultKey)
+	if !ok {
+		return &simpleError{fmt.Sprintf("%q is not a job result key", jobResultKey)}
+	}
+	if interval <= 0 {
+		return &simpleError{fmt.Sprintf("Polling interval must be positive, got %s", interval)}
+	}
+
+	var offset int64
+	var partial []byte
+	for {
+		// Check the state before reading so the final read includes the end of the log
+		result, _, err := r.resultSummary(ctx, jobResultKey)
+		if err != nil {
+			return err
+		}
+		finished := result.Finished || result.LifeCycleState == FinishedLifeCycleState
+
+		chunk, err := r.readBuildLog(ctx, jobKey, jobResultKey, offset)
+		if err != nil {
+			return err
+		}
+		offset += int64(len(chunk))
+
+		partial = append(partial, chunk...)
+		for {
+			i := bytes.IndexByte(partial, '\n')
+			if i < 0 {
+				break
+			}
+			if err := sendLine(ctx, lines, strings.TrimSuffix(string(partial[:i]), "\r")); err != nil {
+				return err
+			}
+			partial = partial[i+1:]
+		}
+
+		if finished {
+			if len(partial) > 0 {
+				return sendLine(ctx, lines, string(partial))
+			}
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(interval):
+		}
+	}
+}
+
+// readBuildLog returns the part of the build log after the given offset
+func (r *ResultService) readBuildLog(ctx context.Context, jobKey, jobResultKey string, offset int64) ([]byte, error) {
+	request, err := r.client.NewRequest(http.MethodGet, buildLogURL(jobKey, jobResultKey), nil)
+	if err != nil {
+		return nil, err
+	}
+	request = request.WithContext(ctx)
+	request.Header.Set("Accept", "text/plain")
+	request.Header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+
+	buf := &bytes.Buffer{}
+	response, err := r.client.Do(request, buf)
+	if err != nil {
+		return nil, err
+	}
+
+	switch response.StatusCode {
+	case http.StatusPartialContent:
+		return buf.Bytes(), nil
+	case http.StatusOK:
+		// The server ignored the range and sent the whole log
+		if int64(buf.Len()) < offset {
+			return nil, nil
+		}
+		return buf.Bytes()[offset:], nil
+	case http.StatusRequestedRangeNotSatisfiable, http.StatusNotFound:
+		// Nothing new has been logged, or the log hasn't been created yet
+		return nil, nil
+	default:
+		return nil, &simpleError{fmt.Sprintf("Reading the build log of %s returned %s", jobResultKey, response.Status)}
+	}
+}
+
+func sendLine(ctx context.Context, lines chan<- string, line string) error {
+	select {
+	case lines <- line:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,111 @@
+package bamboo_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	bamboo "github.com/sukhyun/go-bamboo"
+)
+
+const testBuildLog = "simple\t01-Jan-2020 10:00:00\tBuild started\n"
+
+func TestGetBuildLog(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(buildLogStub))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	buf := &bytes.Buffer{}
+	response, err := client.Results.GetBuildLog("CORE-TEST-JOB1-7", buf)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	assert.Equal(t, testBuildLog, buf.String())
+
+	// Error pages must not be written to the log writer
+	buf.Reset()
+	_, err = client.Results.GetBuildLog("CORE-TEST-JOB1-8", buf)
+	assert.Error(t, err)
+	assert.Equal(t, 0, buf.Len())
+
+	_, err = client.Results.GetBuildLog("CORE-TEST-JOB1", buf)
+	assert.Error(t, err)
+}
+
+func buildLogStub(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/download/CORE-TEST-JOB1/build_logs/CORE-TEST-JOB1-7.log" {
+		http.Error(w, "log not found", http.StatusNotFound)
+		return
+	}
+
+	w.Write([]byte(testBuildLog))
+}
+
+func TestFollowBuildLog(t *testing.T) {
+	polls := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		followBuildLogStub(w, r, &polls)
+	}))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	lines, errs := client.Results.FollowBuildLog(context.Background(), "CORE-TEST-JOB1-7", time.Millisecond)
+
+	received := []string{}
+	for line := range lines {
+		received = append(received, line)
+	}
+
+	assert.NoError(t, <-errs)
+	assert.Equal(t, []string{"line 1", "line 2", "line 3", "done"}, received)
+
+	polls = 0
+	lines, errs = client.Results.FollowBuildLog(context.Background(), "CORE-TEST-JOB1-7", 0)
+	for range lines {
+	}
+	assert.Error(t, <-errs)
+	assert.Equal(t, 0, polls)
+}
+
+// followBuildLogStub serves a log which grows with each poll and finishes on the third poll
+func followBuildLogStub(w http.ResponseWriter, r *http.Request, polls *int) {
+	logs := []string{"line 1\nli", "line 1\nline 2\nline 3\n", "line 1\nline 2\nline 3\ndone"}
+
+	switch r.URL.Path {
+	case "/rest/api/latest/result/CORE-TEST-JOB1-7.json":
+		if r.URL.Query().Get("expand") != "" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		*polls++
+		result := bamboo.Result{Key: "CORE-TEST-JOB1-7", LifeCycleState: "InProgress"}
+		if *polls >= len(logs) {
+			result.LifeCycleState = bamboo.FinishedLifeCycleState
+		}
+		bytes, _ := json.Marshal(result)
+		w.Write(bytes)
+	case "/download/CORE-TEST-JOB1/build_logs/CORE-TEST-JOB1-7.log":
+		log := logs[*polls-1]
+
+		var offset int
+		fmt.Sscanf(r.Header.Get("Range"), "bytes=%d-", &offset)
+		if offset >= len(log) {
+			w.WriteHeader(http.StatusRequestedRangeNotSatisfiable)
+			return
+		}
+
+		w.WriteHeader(http.StatusPartialContent)
+		w.Write([]byte(log[offset:]))
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
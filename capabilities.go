@@ -0,0 +1,81 @@
+package bamboo
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// Capability is a capability of an agent, e.g. the path of a JDK
+// - Key:   The capability key, e.g. "system.jdk.JDK 11"
+// - Value: The capability value, e.g. "/usr/lib/jvm/java-11"
+type Capability struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// SharedCapabilities returns the server capabilities shared by all local agents
+func (a *AgentService) SharedCapabilities() ([]*Capability, *http.Response, error) {
+	request, err := a.client.NewRequest(http.MethodGet, "capability/shared", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	capabilities := []*Capability{}
+	response, err := a.client.Do(request, &capabilities)
+	if err != nil {
+		return nil, response, err
+	}
+
+	if response.StatusCode != 200 {
+		return nil, response, &simpleError{fmt.Sprintf("Listing shared capabilities returned %s", response.Status)}
+	}
+
+	return capabilities, response, nil
+}
+
+// SetSharedCapability adds the given shared capability or replaces the value of an existing one
+func (a *AgentService) SetSharedCapability(capability *Capability) (*http.Response, error) {
+	if capability == nil || emptyStrings(capability.Key) {
+		return nil, &simpleError{"Capability key cannot be empty"}
+	}
+
+	request, err := a.client.NewRequest(http.MethodPut, fmt.Sprintf("capability/shared/%s", url.PathEscape(capability.Key)), capability)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := a.client.Do(request, nil)
+	if err != nil {
+		return response, err
+	}
+
+	if response.StatusCode != 200 && response.StatusCode != 204 {
+		return response, &simpleError{fmt.Sprintf("Setting shared capability %s returned %s", capability.Key, response.Status)}
+	}
+
+	return response, nil
+}
+
+// RemoveSharedCapability removes the shared capability with the given key
+func (a *AgentService) RemoveSharedCapability(key string) (*http.Response, error) {
+	if emptyStrings(key) {
+		return nil, &simpleError{"Capability key cannot be empty"}
+	}
+
+	request, err := a.client.NewRequest(http.MethodDelete, fmt.Sprintf("capability/shared/%s", url.PathEscape(key)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := a.client.Do(request, nil)
+	if err != nil {
+		return response, err
+	}
+
+	if response.StatusCode != 200 && response.StatusCode != 204 {
+		return response, &simpleError{fmt.Sprintf("Removing shared capability %s returned %s", key, response.Status)}
+	}
+
+	return response, nil
+}
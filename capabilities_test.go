@@ -0,0 +1,50 @@
+package bamboo_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	bamboo "github.com/sukhyun/go-bamboo"
+)
+
+func TestSharedCapabilities(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(sharedCapabilitiesStub))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	capabilities, _, err := client.Agents.SharedCapabilities()
+	assert.NoError(t, err)
+	if assert.Len(t, capabilities, 1) {
+		assert.Equal(t, "/usr/lib/jvm/java-11", capabilities[0].Value)
+	}
+
+	_, err = client.Agents.SetSharedCapability(&bamboo.Capability{Key: "system.docker.executable", Value: "/usr/bin/docker"})
+	assert.NoError(t, err)
+	_, err = client.Agents.RemoveSharedCapability("system.docker.executable")
+	assert.NoError(t, err)
+	_, err = client.Agents.SetSharedCapability(&bamboo.Capability{Value: "/usr/bin/docker"})
+	assert.Error(t, err)
+}
+
+func sharedCapabilitiesStub(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == "/rest/api/latest/capability/shared":
+		w.Write([]byte(`[{"key":"system.jdk.JDK 11","value":"/usr/lib/jvm/java-11"}]`))
+	case r.Method == http.MethodPut && r.URL.Path == "/rest/api/latest/capability/shared/system.docker.executable":
+		capability := bamboo.Capability{}
+		if err := json.NewDecoder(r.Body).Decode(&capability); err != nil || capability.Value != "/usr/bin/docker" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodDelete && r.URL.Path == "/rest/api/latest/capability/shared/system.docker.executable":
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusBadRequest)
+	}
+}
@@ -52,6 +52,12 @@ type Client struct {
 	Clone       *CloneService
 	Server      *ServerService
 	Permissions *Permissions
+	Queue       *QueueService
+	Agents      *AgentService
+	Reindex     *ReindexService
+	Audit       *AuditService
+	Plugins     *PluginService
+	Users       *UserService
 }
 
 type service struct {
@@ -88,7 +94,7 @@ func NewSimpleClient(httpClient *http.Client, username, password, token string)
 	}
 	baseURL, _ := url.Parse(defaultBaseURL)
 
-//	c := &Client{client: httpClient, BaseURL: baseURL, SimpleCreds: &SimpleCredentials{Username: username, Password: password}}
+	//	c := &Client{client: httpClient, BaseURL: baseURL, SimpleCreds: &SimpleCredentials{Username: username, Password: password}}
 	var c *Client
 	if len(token) != 0 {
 		c = &Client{client: httpClient, BaseURL: baseURL, SimpleCreds: &SimpleCredentials{UseToken: true, Token: token}}
@@ -108,6 +114,12 @@ func NewSimpleClient(httpClient *http.Client, username, password, token string)
 	c.Clone = (*CloneService)(&c.common)
 	c.Server = (*ServerService)(&c.common)
 	c.Permissions = (*Permissions)(&c.common)
+	c.Queue = (*QueueService)(&c.common)
+	c.Agents = (*AgentService)(&c.common)
+	c.Reindex = (*ReindexService)(&c.common)
+	c.Audit = (*AuditService)(&c.common)
+	c.Plugins = (*PluginService)(&c.common)
+	c.Users = (*UserService)(&c.common)
 	return c
 }
 
@@ -146,7 +158,7 @@ func (c *Client) NewRequest(method, urlStr string, body interface{}) (*http.Requ
 
 	if creds.UseToken {
 		tokenHeader := "Bearer:" + creds.Token
-		req.Header.Set("Authorization", tokenHeader)	
+		req.Header.Set("Authorization", tokenHeader)
 	} else {
 		req.SetBasicAuth(creds.Username, creds.Password)
 	}
@@ -162,8 +174,8 @@ func (c *Client) NewRequest(method, urlStr string, body interface{}) (*http.Requ
 // Do sends an API request and returns the API response. The API response is
 // JSON decoded and stored in the value pointed to by v, or returned as an
 // error if an API error has occurred. If v implements the io.Writer
-// interface, the raw response body will be written to v, without attempting to
-// first decode it. If rate limit is exceeded and reset time is in the future,
+// interface, the raw response body of a successful response will be written to v,
+// without attempting to first decode it. If rate limit is exceeded and reset time is in the future,
 // Do returns *RateLimitError immediately without making a network API call.
 func (c *Client) Do(req *http.Request, v interface{}) (*http.Response, error) {
 
@@ -180,7 +192,10 @@ func (c *Client) Do(req *http.Request, v interface{}) (*http.Response, error) {
 
 	if v != nil {
 		if w, ok := v.(io.Writer); ok {
-			io.Copy(w, resp.Body)
+			// Don't write error pages to the writer
+			if resp.StatusCode < 300 {
+				_, err = io.Copy(w, resp.Body)
+			}
 		} else {
 			err = json.NewDecoder(resp.Body).Decode(v)
 			if err == io.EOF {
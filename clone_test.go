@@ -14,7 +14,7 @@ func TestClonePlan(t *testing.T) {
 	ts := httptest.NewServer(http.HandlerFunc(clonePlanStub))
 	defer ts.Close()
 
-	client := bamboo.NewSimpleClient(nil, "", "")
+	client := bamboo.NewSimpleClient(nil, "", "", "")
 	client.SetURL(ts.URL)
 
 	planClone, resp, err := client.Clone.ClonePlan("CORE-TEST", "CORE-TESTS")
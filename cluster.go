@@ -0,0 +1,132 @@
+package bamboo
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// StartingApplicationState is the state of a Bamboo node which is starting up
+const StartingApplicationState string = "STARTING"
+
+// StoppingApplicationState is the state of a Bamboo node which is shutting down
+const StoppingApplicationState string = "STOPPING"
+
+// ErrorApplicationState is the state of a Bamboo node which failed to start
+const ErrorApplicationState string = "ERROR"
+
+// statusURL is the health check of the node, relative to the REST API base URL
+const statusURL = "../../../status"
+
+// ApplicationStatus is the health of a single Bamboo node
+// - State: RunningState when the node can serve requests, e.g. StartingApplicationState otherwise
+type ApplicationStatus struct {
+	State string `json:"state"`
+}
+
+// Healthy reports whether the node can serve requests
+func (s *ApplicationStatus) Healthy() bool {
+	return s.State == RunningState
+}
+
+// NodeStatus is the state of the Bamboo node answering the request
+// - Primary:       True if the node holds the primary lock and runs scheduled tasks
+// - ClusterMember: True if the node has joined the cluster
+type NodeStatus struct {
+	NodeID        string `json:"nodeId"`
+	NodeName      string `json:"nodeName"`
+	State         string `json:"state"`
+	Primary       bool   `json:"primary"`
+	ClusterMember bool   `json:"clusterMember"`
+}
+
+// HealthCheck returns the health of the Bamboo node, as used by load balancers.
+// An unhealthy node answers with 503, which is not treated as an error so the state can be inspected.
+// If the 503 body isn't a status, e.g. a proxy's error page, the returned state is empty.
+func (s *ServerService) HealthCheck() (*ApplicationStatus, *http.Response, error) {
+	request, err := s.client.NewRequest(http.MethodGet, statusURL, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	status := ApplicationStatus{}
+	response, err := s.client.Do(request, &status)
+	if response != nil && response.StatusCode == 503 {
+		return &status, response, nil
+	}
+
+	if err != nil {
+		return nil, response, err
+	}
+
+	if response.StatusCode != 200 {
+		return nil, response, &simpleError{fmt.Sprintf("Health check returned %s", response.Status)}
+	}
+
+	return &status, response, nil
+}
+
+// NodeStatus returns the state and cluster membership of the Data Center node answering the request
+func (s *ServerService) NodeStatus() (*NodeStatus, *http.Response, error) {
+	request, err := s.client.NewRequest(http.MethodGet, "server/nodeStatus", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	status := NodeStatus{}
+	response, err := s.client.Do(request, &status)
+	if err != nil {
+		return nil, response, err
+	}
+
+	if response.StatusCode != 200 {
+		return nil, response, &simpleError{fmt.Sprintf("Getting node status returned %s", response.Status)}
+	}
+
+	return &status, response, nil
+}
+
+// ClusterNode is a single node of a Bamboo Data Center cluster
+// - Primary:       True if the node holds the primary lock and runs scheduled tasks
+// - LastHeartbeat: When the node last reported to the cluster, in milliseconds since the epoch
+// - Version:       Bamboo version the node is running, e.g. "9.2.1"
+type ClusterNode struct {
+	ID            string `json:"nodeId"`
+	Name          string `json:"nodeName"`
+	Primary       bool   `json:"primary"`
+	Alive         bool   `json:"alive"`
+	LastHeartbeat int64  `json:"lastHeartbeat"`
+	Version       string `json:"version"`
+}
+
+// Heartbeat returns when the node last reported to the cluster
+func (n *ClusterNode) Heartbeat() time.Time {
+	return time.Unix(0, n.LastHeartbeat*int64(time.Millisecond))
+}
+
+type clusterNodesResponse struct {
+	Nodes []*ClusterNode `json:"nodes"`
+}
+
+// ListNodes returns the nodes of the Data Center cluster
+func (s *ServerService) ListNodes() ([]*ClusterNode, *http.Response, error) {
+	request, err := s.client.NewRequest(http.MethodGet, "server/nodes", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	nodes := clusterNodesResponse{}
+	response, err := s.client.Do(request, &nodes)
+	if err != nil {
+		return nil, response, err
+	}
+
+	if response.StatusCode != 200 {
+		return nil, response, &simpleError{fmt.Sprintf("Listing cluster nodes returned %s", response.Status)}
+	}
+
+	if nodes.Nodes == nil {
+		return []*ClusterNode{}, response, nil
+	}
+	return nodes.Nodes, response, nil
+}
@@ -0,0 +1,92 @@
+package bamboo_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	bamboo "github.com/sukhyun/go-bamboo"
+)
+
+func TestHealthCheck(t *testing.T) {
+	state := bamboo.RunningState
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/status" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if state != bamboo.RunningState {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+		if state == "" {
+			w.Write([]byte(`<html><body>Service unavailable</body></html>`))
+			return
+		}
+		w.Write([]byte(`{"state":"` + state + `"}`))
+	}))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	status, _, err := client.Server.HealthCheck()
+	assert.NoError(t, err)
+	assert.True(t, status.Healthy())
+
+	state = bamboo.StartingApplicationState
+	status, response, err := client.Server.HealthCheck()
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusServiceUnavailable, response.StatusCode)
+	assert.False(t, status.Healthy())
+
+	state = ""
+	status, _, err = client.Server.HealthCheck()
+	assert.NoError(t, err)
+	assert.False(t, status.Healthy())
+}
+
+func TestNodeStatus(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/latest/server/nodeStatus" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Write([]byte(`{"nodeId":"a1b2","nodeName":"bamboo-1","state":"RUNNING","primary":true,"clusterMember":true}`))
+	}))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	status, _, err := client.Server.NodeStatus()
+	assert.NoError(t, err)
+	assert.Equal(t, "bamboo-1", status.NodeName)
+	assert.True(t, status.Primary)
+	assert.True(t, status.ClusterMember)
+}
+
+func TestListNodes(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/latest/server/nodes" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Write([]byte(`{"nodes":[
+			{"nodeId":"a1b2","nodeName":"bamboo-1","primary":true,"alive":true,"lastHeartbeat":1700000000000,"version":"9.2.1"},
+			{"nodeId":"c3d4","nodeName":"bamboo-2","primary":false,"alive":true,"lastHeartbeat":1700000005000,"version":"9.2.0"}
+		]}`))
+	}))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	nodes, _, err := client.Server.ListNodes()
+	assert.NoError(t, err)
+	if assert.Len(t, nodes, 2) {
+		assert.True(t, nodes[0].Primary)
+		assert.Equal(t, "9.2.0", nodes[1].Version)
+		assert.Equal(t, int64(1700000005), nodes[1].Heartbeat().Unix())
+	}
+}
@@ -9,9 +9,23 @@ import (
 type CommentService service
 
 // Comment is a single comment on a result
+// - CreationDate: When the comment was added, in milliseconds since the epoch
 type Comment struct {
-	Content   string `json:"content"`
-	ResultKey string `json:"-"`
+	ID           int    `json:"id,omitempty"`
+	Author       string `json:"author,omitempty"`
+	Content      string `json:"content"`
+	CreationDate int64  `json:"creationDate,omitempty"`
+	ResultKey    string `json:"-"`
+}
+
+// Comments is the collection of comments on a result
+type Comments struct {
+	*CollectionMetadata
+	CommentList []*Comment `json:"comment"`
+}
+
+type commentsResponse struct {
+	Comments *Comments `json:"comments"`
 }
 
 func (cm Comment) isEmpty() bool {
@@ -43,3 +57,61 @@ func (c *CommentService) AddComment(comment *Comment) (bool, *http.Response, err
 
 	return true, response, nil
 }
+
+// ListComments returns the comments on the given result
+func (c *CommentService) ListComments(resultKey string) ([]*Comment, *http.Response, error) {
+	if emptyStrings(resultKey) {
+		return nil, nil, &simpleError{"Result key cannot be empty"}
+	}
+
+	request, err := c.client.NewRequest(http.MethodGet, fmt.Sprintf("result/%s/comment.json", resultKey), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	values := request.URL.Query()
+	values.Set("expand", "comments.comment")
+	request.URL.RawQuery = values.Encode()
+
+	data := commentsResponse{}
+	response, err := c.client.Do(request, &data)
+	if err != nil {
+		return nil, response, err
+	}
+
+	if !(response.StatusCode == 200) {
+		return nil, response, &simpleError{fmt.Sprintf("Listing comments of %s returned %s", resultKey, response.Status)}
+	}
+
+	if data.Comments == nil {
+		return []*Comment{}, response, nil
+	}
+
+	for _, comment := range data.Comments.CommentList {
+		comment.ResultKey = resultKey
+	}
+	return data.Comments.CommentList, response, nil
+}
+
+// DeleteComment removes the comment with the given id from the given result
+func (c *CommentService) DeleteComment(resultKey string, commentID int) (bool, *http.Response, error) {
+	if emptyStrings(resultKey) {
+		return false, nil, &simpleError{"Result key cannot be empty"}
+	}
+
+	request, err := c.client.NewRequest(http.MethodDelete, fmt.Sprintf("result/%s/comment/%d", resultKey, commentID), nil)
+	if err != nil {
+		return false, nil, err
+	}
+
+	response, err := c.client.Do(request, nil)
+	if err != nil {
+		return false, response, err
+	}
+
+	if !(response.StatusCode == 204) {
+		return false, response, &simpleError{fmt.Sprintf("Deleting comment %d from %s returned %s", commentID, resultKey, response.Status)}
+	}
+
+	return true, response, nil
+}
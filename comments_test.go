@@ -19,7 +19,7 @@ func TestAddComment(t *testing.T) {
 	ts := httptest.NewServer(http.HandlerFunc(addCommentStub))
 	defer ts.Close()
 
-	client := bamboo.NewSimpleClient(nil, "", "")
+	client := bamboo.NewSimpleClient(nil, "", "", "")
 	client.SetURL(ts.URL)
 
 	comment := &bamboo.Comment{
@@ -55,3 +55,66 @@ func addCommentStub(w http.ResponseWriter, r *http.Request) {
 
 	w.WriteHeader(http.StatusNoContent)
 }
+
+func TestListComments(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(listCommentsStub))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	comments, _, err := client.Comments.ListComments(resultCommentKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(comments) != 1 || comments[0].Content != testComment || comments[0].ResultKey != resultCommentKey {
+		t.Errorf("Unexpected comments %+v", comments)
+	}
+}
+
+func listCommentsStub(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != fmt.Sprintf("/rest/api/latest/result/%s/comment.json", resultCommentKey) {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	resp := map[string]*bamboo.Comments{
+		"comments": &bamboo.Comments{CommentList: []*bamboo.Comment{
+			&bamboo.Comment{ID: 3, Author: "triage-bot", Content: testComment},
+		}},
+	}
+
+	bytes, err := json.Marshal(resp)
+	if err != nil {
+		panic(err)
+	}
+
+	w.Write(bytes)
+}
+
+func TestDeleteComment(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(deleteCommentStub))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	success, resp, err := client.Comments.DeleteComment(resultCommentKey, 3)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if success == false || resp.StatusCode != 204 {
+		t.Error(fmt.Sprintf("Deleting comment 3 was unsuccessful. Returned %s", resp.Status))
+	}
+}
+
+func deleteCommentStub(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodDelete || r.URL.Path != fmt.Sprintf("/rest/api/latest/result/%s/comment/3", resultCommentKey) {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,148 @@
+package bamboo
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// maxComparedResults is how many builds apart CompareResults accepts, as it reads the
+// changes of every build in between
+const maxComparedResults = 100
+
+// ResultComparison holds what changed between two results of the same plan
+// - NewFailures:    Tests failing in the newer result which didn't fail in the older one
+// - Fixed:          Tests failing in the older result which pass in the newer one
+// - Changes:        VCS changes built after the older result, up to and including the newer one
+// - MissingResults: Results in between which were deleted, e.g. by expiry, so their changes are missing
+// - DurationDelta:  How much longer the newer build took, negative if it was faster
+type ResultComparison struct {
+	From           *Result
+	To             *Result
+	NewFailures    []*TestCase
+	Fixed          []*TestCase
+	Changes        []Change
+	MissingResults []string
+	DurationDelta  time.Duration
+}
+
+// CompareResults compares two results of the same plan, e.g. the last successful
+// result "PROJ-PLAN-120" with the failing "PROJ-PLAN-123". The tests of every job of
+// both results are compared. The changes are read with one request per build in between,
+// so the results may be at most 100 builds apart.
+func (r *ResultService) CompareResults(fromKey, toKey string) (*ResultComparison, *http.Response, error) {
+	fromPlan, fromNumber, fromOK := splitResultKey(fromKey)
+	toPlan, toNumber, toOK := splitResultKey(toKey)
+	if !fromOK || !toOK || fromPlan != toPlan || fromNumber >= toNumber {
+		return nil, nil, &simpleError{fmt.Sprintf("%q and %q are not an older and a newer result of the same plan", fromKey, toKey)}
+	}
+	if toNumber-fromNumber > maxComparedResults {
+		return nil, nil, &simpleError{fmt.Sprintf("%q and %q are more than %d builds apart", fromKey, toKey, maxComparedResults)}
+	}
+
+	comparison := &ResultComparison{}
+	from, response, err := r.GetChainResult(fromKey)
+	if err != nil {
+		return nil, response, err
+	}
+	to, response, err := r.GetChainResult(toKey)
+	if err != nil {
+		return nil, response, err
+	}
+	comparison.From, comparison.To = from.Result, to.Result
+	comparison.DurationDelta = to.Duration() - from.Duration()
+
+	fromTests, response, err := r.chainTestResults(from)
+	if err != nil {
+		return nil, response, err
+	}
+	toTests, response, err := r.chainTestResults(to)
+	if err != nil {
+		return nil, response, err
+	}
+	comparison.NewFailures = failedTestsNotIn(toTests, fromTests)
+	comparison.Fixed = fixedTests(fromTests, toTests)
+
+	comparison.Changes = []Change{}
+	comparison.MissingResults = []string{}
+	for n := fromNumber + 1; n <= toNumber; n++ {
+		resultKey := fmt.Sprintf("%s-%d", toPlan, n)
+		changes, resp, err := r.ResultChanges(resultKey)
+		switch {
+		case resp != nil && resp.StatusCode == 404:
+			comparison.MissingResults = append(comparison.MissingResults, resultKey)
+			continue
+		case err != nil:
+			return nil, resp, err
+		}
+		comparison.Changes = append(comparison.Changes, changes...)
+		response = resp
+	}
+
+	return comparison, response, nil
+}
+
+// chainTestResults returns the combined tests of every job of the chain result,
+// as Bamboo only reports tests on job results
+func (r *ResultService) chainTestResults(chain *ChainResult) (*TestResults, *http.Response, error) {
+	combined := &TestResults{AllTests: &TestCases{}, FailedTests: &TestCases{}}
+	var response *http.Response
+	for _, job := range chain.Jobs() {
+		tests, resp, err := r.GetTestResults(job.Key)
+		if err != nil {
+			return nil, resp, err
+		}
+		response = resp
+
+		if tests.AllTests != nil {
+			combined.AllTests.TestCaseList = append(combined.AllTests.TestCaseList, tests.AllTests.TestCaseList...)
+		}
+		if tests.FailedTests != nil {
+			combined.FailedTests.TestCaseList = append(combined.FailedTests.TestCaseList, tests.FailedTests.TestCaseList...)
+		}
+	}
+	return combined, response, nil
+}
+
+// failedTestsNotIn returns the failed tests of a which didn't fail in b
+func failedTestsNotIn(a, b *TestResults) []*TestCase {
+	failed := map[string]bool{}
+	if b.FailedTests != nil {
+		for _, test := range b.FailedTests.TestCaseList {
+			failed[test.ClassName+"."+test.MethodName] = true
+		}
+	}
+
+	tests := []*TestCase{}
+	if a.FailedTests != nil {
+		for _, test := range a.FailedTests.TestCaseList {
+			if !failed[test.ClassName+"."+test.MethodName] {
+				tests = append(tests, test)
+			}
+		}
+	}
+	return tests
+}
+
+// fixedTests returns the failed tests of from which passed in to, tests which
+// were skipped or removed in to aren't fixed
+func fixedTests(from, to *TestResults) []*TestCase {
+	passed := map[string]bool{}
+	if to.AllTests != nil {
+		for _, test := range to.AllTests.TestCaseList {
+			if test.Status == PassedTestStatus {
+				passed[test.ClassName+"."+test.MethodName] = true
+			}
+		}
+	}
+
+	tests := []*TestCase{}
+	if from.FailedTests != nil {
+		for _, test := range from.FailedTests.TestCaseList {
+			if passed[test.ClassName+"."+test.MethodName] {
+				tests = append(tests, test)
+			}
+		}
+	}
+	return tests
+}
@@ -0,0 +1,84 @@
+package bamboo_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	bamboo "github.com/sukhyun/go-bamboo"
+)
+
+func TestCompareResults(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(compareResultsStub))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	comparison, _, err := client.Results.CompareResults("CORE-TEST-5", "CORE-TEST-8")
+	assert.NoError(t, err)
+	assert.Equal(t, 30*time.Second, comparison.DurationDelta)
+	if assert.Len(t, comparison.NewFailures, 1) {
+		assert.Equal(t, "testLogout", comparison.NewFailures[0].MethodName)
+	}
+	if assert.Len(t, comparison.Fixed, 1) {
+		assert.Equal(t, "testLogin", comparison.Fixed[0].MethodName)
+	}
+	if assert.Len(t, comparison.Changes, 2) {
+		assert.Equal(t, "aaa111", comparison.Changes[0].ChangeSetID)
+		assert.Equal(t, "ccc333", comparison.Changes[1].ChangeSetID)
+	}
+	assert.Equal(t, []string{"CORE-TEST-7"}, comparison.MissingResults)
+
+	_, _, err = client.Results.CompareResults("CORE-TEST-8", "CORE-TEST-5")
+	assert.Error(t, err)
+	_, _, err = client.Results.CompareResults("CORE-TEST-5", "CORE-OTHER-8")
+	assert.Error(t, err)
+	_, _, err = client.Results.CompareResults("CORE-TEST-5", "CORE-TEST-500")
+	assert.Error(t, err)
+}
+
+func compareResultsStub(w http.ResponseWriter, r *http.Request) {
+	expand := r.URL.Query().Get("expand")
+	tests := "testResults.allTests,testResults.failedTests,testResults.newFailedTests"
+	chain := "stages.stage.results.result"
+
+	// Each result runs a JOB1 and a JOB2 job, the tests are reported on the job results
+	switch {
+	case r.URL.Path == "/rest/api/latest/result/CORE-TEST-5.json" && expand == chain:
+		w.Write([]byte(`{"key":"CORE-TEST-5","buildDuration":60000,"buildDurationInSeconds":60,"stages":{"stage":[{"results":{"result":[
+			{"key":"CORE-TEST-JOB1-5"},{"key":"CORE-TEST-JOB2-5"}]}}]}}`))
+	case r.URL.Path == "/rest/api/latest/result/CORE-TEST-8.json" && expand == chain:
+		w.Write([]byte(`{"key":"CORE-TEST-8","buildDuration":90000,"buildDurationInSeconds":90,"stages":{"stage":[{"results":{"result":[
+			{"key":"CORE-TEST-JOB1-8"},{"key":"CORE-TEST-JOB2-8"}]}}]}}`))
+	case r.URL.Path == "/rest/api/latest/result/CORE-TEST-JOB1-5.json" && expand == tests:
+		w.Write([]byte(`{"testResults":{"failedTests":{"testResult":[
+			{"className":"LoginTest","methodName":"testLogin","status":"failed"},
+			{"className":"LoginTest","methodName":"testRemoved","status":"failed"}]}}}`))
+	case r.URL.Path == "/rest/api/latest/result/CORE-TEST-JOB2-5.json" && expand == tests:
+		w.Write([]byte(`{"testResults":{"failedTests":{"testResult":[
+			{"className":"LoginTest","methodName":"testSkipped","status":"failed"},
+			{"className":"LoginTest","methodName":"testFlaky","status":"failed"}]}}}`))
+	case r.URL.Path == "/rest/api/latest/result/CORE-TEST-JOB1-8.json" && expand == tests:
+		w.Write([]byte(`{"testResults":{"allTests":{"testResult":[
+			{"className":"LoginTest","methodName":"testLogin","status":"successful"},
+			{"className":"LoginTest","methodName":"testLogout","status":"failed"}]},
+			"failedTests":{"testResult":[
+			{"className":"LoginTest","methodName":"testLogout","status":"failed"}]}}}`))
+	case r.URL.Path == "/rest/api/latest/result/CORE-TEST-JOB2-8.json" && expand == tests:
+		w.Write([]byte(`{"testResults":{"allTests":{"testResult":[
+			{"className":"LoginTest","methodName":"testSkipped","status":"skipped"},
+			{"className":"LoginTest","methodName":"testFlaky","status":"failed"}]},
+			"failedTests":{"testResult":[
+			{"className":"LoginTest","methodName":"testFlaky","status":"failed"}]}}}`))
+	case r.URL.Path == "/rest/api/latest/result/CORE-TEST-6.json" && expand == "changes.change.files":
+		w.Write([]byte(`{"changes":{"change":[{"changesetId":"aaa111"}]}}`))
+	case r.URL.Path == "/rest/api/latest/result/CORE-TEST-8.json" && expand == "changes.change.files":
+		w.Write([]byte(`{"changes":{"change":[{"changesetId":"ccc333"}]}}`))
+	default:
+		// CORE-TEST-7 has expired
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
@@ -0,0 +1,66 @@
+package bamboo
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+type darkFeaturesResponse struct {
+	Enabled []string `json:"enabled"`
+}
+
+// DarkFeatures returns the keys of the dark features enabled on the server
+func (s *ServerService) DarkFeatures() ([]string, *http.Response, error) {
+	request, err := s.client.NewRequest(http.MethodGet, "darkFeature", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	features := darkFeaturesResponse{}
+	response, err := s.client.Do(request, &features)
+	if err != nil {
+		return nil, response, err
+	}
+
+	if response.StatusCode != 200 {
+		return nil, response, &simpleError{fmt.Sprintf("Listing dark features returned %s", response.Status)}
+	}
+
+	if features.Enabled == nil {
+		return []string{}, response, nil
+	}
+	return features.Enabled, response, nil
+}
+
+// EnableDarkFeature enables the dark feature with the given key for the whole server
+func (s *ServerService) EnableDarkFeature(key string) (*http.Response, error) {
+	return s.changeDarkFeature(http.MethodPut, key, "Enabling")
+}
+
+// DisableDarkFeature disables the dark feature with the given key
+func (s *ServerService) DisableDarkFeature(key string) (*http.Response, error) {
+	return s.changeDarkFeature(http.MethodDelete, key, "Disabling")
+}
+
+func (s *ServerService) changeDarkFeature(method, key, action string) (*http.Response, error) {
+	if emptyStrings(key) {
+		return nil, &simpleError{"Dark feature key cannot be empty"}
+	}
+
+	request, err := s.client.NewRequest(method, fmt.Sprintf("darkFeature/%s", url.PathEscape(key)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.client.Do(request, nil)
+	if err != nil {
+		return response, err
+	}
+
+	if response.StatusCode != 200 && response.StatusCode != 204 {
+		return response, &simpleError{fmt.Sprintf("%s dark feature %s returned %s", action, key, response.Status)}
+	}
+
+	return response, nil
+}
@@ -0,0 +1,42 @@
+package bamboo_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	bamboo "github.com/sukhyun/go-bamboo"
+)
+
+func TestDarkFeatures(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(darkFeaturesStub))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	features, _, err := client.Server.DarkFeatures()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"bamboo.specs.project"}, features)
+
+	_, err = client.Server.EnableDarkFeature("bamboo.specs.project")
+	assert.NoError(t, err)
+	_, err = client.Server.DisableDarkFeature("bamboo.specs.project")
+	assert.NoError(t, err)
+	_, err = client.Server.EnableDarkFeature("")
+	assert.Error(t, err)
+}
+
+func darkFeaturesStub(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == "/rest/api/latest/darkFeature":
+		w.Write([]byte(`{"enabled":["bamboo.specs.project"]}`))
+	case r.Method == http.MethodPut && r.URL.Path == "/rest/api/latest/darkFeature/bamboo.specs.project":
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodDelete && r.URL.Path == "/rest/api/latest/darkFeature/bamboo.specs.project":
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusBadRequest)
+	}
+}
@@ -1,10 +1,27 @@
 package bamboo
 
 import (
+	"context"
 	"fmt"
+	"io"
 	"net/http"
+	"strconv"
+	"time"
 )
 
+// SuccessfulDeploymentState is the deployment state of a successful deployment
+const SuccessfulDeploymentState string = "SUCCESS"
+
+// FailedDeploymentState is the deployment state of a failed deployment
+const FailedDeploymentState string = "FAILED"
+
+// FinishedDeploymentLifeCycleState is the life cycle state of a deployment which has completed
+const FinishedDeploymentLifeCycleState string = "FINISHED"
+
+// NotBuiltDeploymentLifeCycleState is the life cycle state of a deployment which was stopped or
+// dropped before it ran
+const NotBuiltDeploymentLifeCycleState string = "NOT_BUILT"
+
 // DeployService handles communication with the deploy related methods
 type DeployService service
 
@@ -23,18 +40,34 @@ type Deploy struct {
 	Name         string               `json:"name,omitempty"`
 	Description  string               `json:"description,omitempty"`
 	Environments []*DeployEnvironment `json:"environments,omitempty"`
+	Operations   *DeployOperations    `json:"operations,omitempty"`
+}
+
+// DeployOperations holds the operations the caller is allowed to perform
+// on a deployment project or environment
+type DeployOperations struct {
+	CanView                   bool `json:"canView"`
+	CanEdit                   bool `json:"canEdit"`
+	CanDelete                 bool `json:"canDelete"`
+	AllowedToExecute          bool `json:"allowedToExecute"`
+	CanExecute                bool `json:"canExecute"`
+	AllowedToCreateVersion    bool `json:"allowedToCreateVersion"`
+	AllowedToSetVersionStatus bool `json:"allowedToSetVersionStatus"`
 }
 
 // DeployEnvironment is the information for an environment
 type DeployEnvironment struct {
-	ID                  int    `json:"id"`
-	Name                string `json:"name"`
-	Description         string `json:"description,omitempty"`
-	DeploymentProjectID int    `json:"deploymentProjectId,omitempty"`
+	ID                  int               `json:"id"`
+	Name                string            `json:"name"`
+	Description         string            `json:"description,omitempty"`
+	DeploymentProjectID int               `json:"deploymentProjectId,omitempty"`
+	Position            int               `json:"position,omitempty"`
+	Operations          *DeployOperations `json:"operations,omitempty"`
 }
 
 // DeployEnvironmentResults is the information for a single Deploy
 type DeployEnvironmentResults struct {
+	*CollectionMetadata
 	Name    string          `json:"name"`
 	ID      int             `json:"id"`
 	Results []*DeployStatus `json:"results"`
@@ -53,32 +86,154 @@ type QueueDeployRequest struct {
 }
 
 // DeployStatus contains deploy status information
+// - StartedDate:   When the deployment started, in milliseconds since the epoch
+// - FinishedDate:  When the deployment finished, zero while it's running
+// - ReasonSummary: Who or what triggered the deployment, as HTML
 type DeployStatus struct {
+	ID                    int                `json:"id,omitempty"`
 	DeploymentVersion     *DeploymentVersion `json:"deploymentVersion"`
 	DeploymentVersionName string             `json:"deploymentVersionName"`
 	DeploymentState       string             `json:"deploymentState"`
 	LifeCycleState        string             `json:"lifeCycleState"`
 	StartedDate           int                `json:"startedDate"`
+	QueuedDate            int64              `json:"queuedDate,omitempty"`
+	ExecutedDate          int64              `json:"executedDate,omitempty"`
+	FinishedDate          int64              `json:"finishedDate,omitempty"`
+	ReasonSummary         string             `json:"reasonSummary,omitempty"`
+}
+
+// Started returns when the deployment started
+func (s *DeployStatus) Started() time.Time {
+	return time.Unix(0, int64(s.StartedDate)*int64(time.Millisecond))
+}
+
+// Finished returns when the deployment finished, or the zero time while it's running
+func (s *DeployStatus) Finished() time.Time {
+	if s.FinishedDate == 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, s.FinishedDate*int64(time.Millisecond))
+}
+
+// DeploymentResult is a deployment result together with its log
+type DeploymentResult struct {
+	*DeployStatus
+	LogEntries *DeploymentLogEntries `json:"logEntries,omitempty"`
+}
+
+// DeploymentLogEntries is the collection of log lines of a deployment result
+type DeploymentLogEntries struct {
+	*CollectionMetadata
+	EntryList []*DeploymentLogEntry `json:"logEntry"`
+}
+
+// DeploymentLogEntry is a single line of a deployment log
+// - Log:         The line with HTML markup
+// - UnstyledLog: The plain text line
+// - Date:        When the line was logged, in milliseconds since the epoch
+type DeploymentLogEntry struct {
+	Log         string `json:"log"`
+	UnstyledLog string `json:"unstyledLog"`
+	Date        int64  `json:"date"`
+}
+
+// DeploymentDashboard is what is deployed to each environment of a deployment project
+type DeploymentDashboard struct {
+	DeploymentProject   *Deploy              `json:"deploymentProject"`
+	EnvironmentStatuses []*EnvironmentStatus `json:"environmentStatuses"`
+}
+
+// EnvironmentStatus holds the latest deployment to an environment
+// - DeploymentResult: The latest deployment, nil if nothing was deployed to the environment yet
+type EnvironmentStatus struct {
+	Environment      *DeployEnvironment `json:"environment"`
+	DeploymentResult *DeployStatus      `json:"deploymentResult,omitempty"`
+}
+
+// EnvironmentTask is a task configured on a deployment environment
+// - PluginKey: The task type, e.g. "com.atlassian.bamboo.plugins.scripttask:task.builder.script"
+// - Final:     Whether the task runs even if an earlier task failed
+type EnvironmentTask struct {
+	ID          int    `json:"id"`
+	PluginKey   string `json:"pluginKey"`
+	Description string `json:"userDescription,omitempty"`
+	Enabled     bool   `json:"isEnabled"`
+	Final       bool   `json:"isFinal"`
+}
+
+type environmentTasksResponse struct {
+	Tasks []*EnvironmentTask `json:"tasks"`
+}
+
+// DeploymentPreview is what a deployment of a release to an environment would change
+// - CurrentVersion: The release currently deployed to the environment, nil if there is none
+// - Changes:        The VCS changes built after the current release, up to the previewed release
+// - JiraIssues:     The JIRA issues linked to those changes
+// - Artifacts:      The artifacts the previewed release would deploy
+type DeploymentPreview struct {
+	CurrentVersion *DeployVersionResult `json:"currentVersion,omitempty"`
+	Version        *DeployVersionResult `json:"version"`
+	Changes        ChangeSet            `json:"changes"`
+	JiraIssues     *JiraIssues          `json:"jiraIssues,omitempty"`
+	Artifacts      *Artifacts           `json:"artifacts,omitempty"`
+}
+
+// VersionStatuses holds the latest deployment of a release to each environment it was deployed to
+type VersionStatuses []*EnvironmentStatus
+
+// Succeeded reports whether the latest deployment of the release to the given environment was successful
+func (v VersionStatuses) Succeeded(environmentID int) bool {
+	for _, status := range v {
+		if status.Environment != nil && status.Environment.ID == environmentID {
+			return status.DeploymentResult != nil && status.DeploymentResult.DeploymentState == SuccessfulDeploymentState
+		}
+	}
+	return false
 }
 
 type createDeploymentVersion struct {
 	PlanResultKey   string `json:"planResultKey"`
 	Name            string `json:"name"`
-	NextVersionName string `json:"nextVersionName"`
+	NextVersionName string `json:"nextVersionName,omitempty"`
+}
+
+type deploymentProjectRequest struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	PlanKey     *PlanKey `json:"planKey"`
+}
+
+type environmentRequest struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
 }
 
 // DeployVersionResult will have the information for creating a
 // new release/version for bamboo
+// - PlanBranchName: The plan branch the release was created from
 type DeployVersionResult struct {
-	ID   int    `json:"id"`
-	Name string `json:"name"`
+	ID              int    `json:"id"`
+	Name            string `json:"name"`
+	PlanBranchName  string `json:"planBranchName,omitempty"`
+	CreationDate    int64  `json:"creationDate,omitempty"`
+	CreatorUserName string `json:"creatorUserName,omitempty"`
 }
 
 // DeployVersionListResult stores a list of deployment versions
 type DeployVersionListResult struct {
+	*CollectionMetadata
 	Versions []*DeployVersionResult `json:"versions"`
 }
 
+// DeployVersionListOptions specifies the optional parameters
+// for listing the releases of a deployment project
+// - Page:      Only return the given page of releases, nil returns every release
+// - BranchKey: Only return releases created from the given plan branch, e.g. "PROJ-PLAN3"
+type DeployVersionListOptions struct {
+	Page      *Pagination
+	BranchKey string
+}
+
 // CreateDeployVersion will take a deploy project id, plan result, version name and the next version name and create a release.
 func (d *DeployService) CreateDeployVersion(deploymentProjectID int, planResultKey, versionName, nextVersionName string) (*DeployVersionResult, error) {
 
@@ -107,6 +262,144 @@ func (d *DeployService) CreateDeployVersion(deploymentProjectID int, planResultK
 	return result, nil
 }
 
+// CreateVersion creates a release of the given deployment project from the artifacts of a
+// build result, e.g. "PROJ-PLAN-123", leaving the suggested name of the next release unchanged
+func (d *DeployService) CreateVersion(deploymentProjectID int, buildResultKey, versionName string) (*DeployVersionResult, error) {
+	if _, _, ok := splitResultKey(buildResultKey); !ok || emptyStrings(versionName) {
+		return nil, &simpleError{fmt.Sprintf("%q is not a result key or the version name is empty", buildResultKey)}
+	}
+
+	return d.CreateDeployVersion(deploymentProjectID, buildResultKey, versionName, "")
+}
+
+// UpdateVersion renames the given release
+func (d *DeployService) UpdateVersion(versionID int, name string) (*DeployVersionResult, error) {
+	if emptyStrings(name) {
+		return nil, &simpleError{"Version name cannot be empty"}
+	}
+
+	request, err := d.client.NewRequest(http.MethodPost, fmt.Sprintf("deploy/version/%d", versionID), &DeployVersionResult{ID: versionID, Name: name})
+	if err != nil {
+		return nil, err
+	}
+
+	version := &DeployVersionResult{}
+	response, err := d.client.Do(request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.StatusCode == http.StatusNotFound {
+		return nil, ErrNotFound
+	}
+
+	if response.StatusCode != http.StatusOK {
+		return nil, newRespErr(response, "Error renaming deploy version")
+	}
+
+	return version, nil
+}
+
+// DeleteVersion deletes the given release. Its deployment results are kept.
+func (d *DeployService) DeleteVersion(versionID int) error {
+	request, err := d.client.NewRequest(http.MethodDelete, fmt.Sprintf("deploy/version/%d", versionID), nil)
+	if err != nil {
+		return err
+	}
+
+	response, err := d.client.Do(request, nil)
+	if err != nil {
+		return err
+	}
+
+	if response.StatusCode != http.StatusOK && response.StatusCode != http.StatusNoContent {
+		return newRespErr(response, "Error deleting deploy version")
+	}
+
+	return nil
+}
+
+// VersionStatus returns the environments the given release was deployed to and the outcome
+// of the latest deployment to each of them
+func (d *DeployService) VersionStatus(versionID int) (VersionStatuses, error) {
+	request, err := d.client.NewRequest(http.MethodGet, fmt.Sprintf("deploy/version/%d/status", versionID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := VersionStatuses{}
+	response, err := d.client.Do(request, &statuses)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.StatusCode == http.StatusNotFound {
+		return nil, ErrNotFound
+	}
+
+	if response.StatusCode != http.StatusOK {
+		return nil, newRespErr(response, "Error getting deploy version status")
+	}
+
+	return statuses, nil
+}
+
+// ListVersions returns the releases of the given deployment project, newest first
+func (d *DeployService) ListVersions(deploymentProjectID int, options *DeployVersionListOptions) ([]*DeployVersionResult, error) {
+	branchKey := ""
+	if options != nil {
+		branchKey = options.BranchKey
+		if options.Page != nil {
+			versions, err := d.listVersionsPage(deploymentProjectID, branchKey, *options.Page)
+			if err != nil {
+				return nil, err
+			}
+			return versions.Versions, nil
+		}
+	}
+
+	versionList := []*DeployVersionResult{}
+	next := Pagination{Limit: defaultPageSize}
+	for {
+		versions, err := d.listVersionsPage(deploymentProjectID, branchKey, next)
+		if err != nil {
+			return nil, err
+		}
+
+		versionList = append(versionList, versions.Versions...)
+		next.Start += len(versions.Versions)
+		if len(versions.Versions) == 0 || !versions.hasMore(next.Start) {
+			return versionList, nil
+		}
+	}
+}
+
+func (d *DeployService) listVersionsPage(deploymentProjectID int, branchKey string, page Pagination) (*DeployVersionListResult, error) {
+	request, err := d.client.NewRequest(http.MethodGet, fmt.Sprintf("deploy/project/%d/versions", deploymentProjectID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	values := request.URL.Query()
+	page.setIndexQuery(values)
+	if branchKey != "" {
+		values.Set("branchKey", branchKey)
+	}
+	request.URL.RawQuery = values.Encode()
+
+	versions := &DeployVersionListResult{}
+	response, err := d.client.Do(request, versions)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.StatusCode != http.StatusOK {
+		return nil, newRespErr(response, "Error listing deploy versions")
+	}
+
+	return versions, nil
+}
+
 // ListDeploys lists all deployments
 func (d *DeployService) ListDeploys() (DeploysResponse, error) {
 	request, err := d.client.NewRequest(http.MethodGet, "deploy/project/all", nil)
@@ -127,6 +420,143 @@ func (d *DeployService) ListDeploys() (DeploysResponse, error) {
 	return deployResp, nil
 }
 
+// GetDeploymentProject returns the deployment project with the given ID,
+// including its environments and the operations allowed for the caller
+func (d *DeployService) GetDeploymentProject(id int) (*Deploy, error) {
+	request, err := d.client.NewRequest(http.MethodGet, fmt.Sprintf("deploy/project/%d", id), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	deploy := &Deploy{}
+	response, err := d.client.Do(request, deploy)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.StatusCode == http.StatusNotFound {
+		return nil, ErrNotFound
+	}
+
+	if response.StatusCode != http.StatusOK {
+		return nil, newRespErr(response, "Error getting deployment project")
+	}
+
+	return deploy, nil
+}
+
+// CreateDeploymentProject creates a deployment project for the artifacts of the given plan
+func (d *DeployService) CreateDeploymentProject(name, planKey, description string) (*Deploy, error) {
+	if emptyStrings(name, planKey) {
+		return nil, &simpleError{"Name and/or plan key cannot be empty"}
+	}
+
+	project := &deploymentProjectRequest{
+		Name:        name,
+		Description: description,
+		PlanKey:     &PlanKey{Key: planKey},
+	}
+
+	request, err := d.client.NewRequest(http.MethodPut, "deploy/project", project)
+	if err != nil {
+		return nil, err
+	}
+
+	deploy := &Deploy{}
+	response, err := d.client.Do(request, deploy)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.StatusCode != http.StatusOK && response.StatusCode != http.StatusCreated {
+		return nil, newRespErr(response, "Error creating deployment project")
+	}
+
+	return deploy, nil
+}
+
+// UpdateDeploymentProject renames the given deployment project, links it to the given plan
+// and replaces its description
+func (d *DeployService) UpdateDeploymentProject(id int, name, planKey, description string) (*Deploy, error) {
+	if emptyStrings(name, planKey) {
+		return nil, &simpleError{"Name and/or plan key cannot be empty"}
+	}
+
+	project := &deploymentProjectRequest{
+		Name:        name,
+		Description: description,
+		PlanKey:     &PlanKey{Key: planKey},
+	}
+
+	request, err := d.client.NewRequest(http.MethodPost, fmt.Sprintf("deploy/project/%d", id), project)
+	if err != nil {
+		return nil, err
+	}
+
+	deploy := &Deploy{}
+	response, err := d.client.Do(request, deploy)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.StatusCode == http.StatusNotFound {
+		return nil, ErrNotFound
+	}
+
+	if response.StatusCode != http.StatusOK {
+		return nil, newRespErr(response, "Error updating deployment project")
+	}
+
+	return deploy, nil
+}
+
+// DeleteDeploymentProject deletes the given deployment project with its environments and versions
+func (d *DeployService) DeleteDeploymentProject(id int) error {
+	request, err := d.client.NewRequest(http.MethodDelete, fmt.Sprintf("deploy/project/%d", id), nil)
+	if err != nil {
+		return err
+	}
+
+	response, err := d.client.Do(request, nil)
+	if err != nil {
+		return err
+	}
+
+	if response.StatusCode != http.StatusOK && response.StatusCode != http.StatusNoContent {
+		return newRespErr(response, "Error deleting deployment project")
+	}
+
+	return nil
+}
+
+// DeploymentProjectsForPlan returns the deployment projects which deploy the artifacts of the given plan
+func (d *DeployService) DeploymentProjectsForPlan(planKey string) (DeploysResponse, error) {
+	if emptyStrings(planKey) {
+		return nil, &simpleError{"Plan key cannot be empty"}
+	}
+
+	request, err := d.client.NewRequest(http.MethodGet, "deploy/project/forPlan", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	values := request.URL.Query()
+	values.Set("planKey", planKey)
+	request.URL.RawQuery = values.Encode()
+
+	deployResp := DeploysResponse{}
+	response, err := d.client.Do(request, &deployResp)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.StatusCode != http.StatusOK {
+		return nil, newRespErr(response, "Error listing deployment projects for plan")
+	}
+
+	return deployResp, nil
+}
+
 // DeployEnvironments returns information on the requested environment
 func (d *DeployService) DeployEnvironments(id int) (*DeployEnvironment, error) {
 	request, err := d.client.NewRequest(http.MethodGet, fmt.Sprintf("deploy/project/%d", id), nil)
@@ -147,6 +577,139 @@ func (d *DeployService) DeployEnvironments(id int) (*DeployEnvironment, error) {
 	return deployEnvironmentResp, nil
 }
 
+// AddEnvironment adds an environment to the given deployment project
+func (d *DeployService) AddEnvironment(deploymentProjectID int, name, description string) (*DeployEnvironment, error) {
+	if emptyStrings(name) {
+		return nil, &simpleError{"Environment name cannot be empty"}
+	}
+
+	environment := &environmentRequest{
+		Name:        name,
+		Description: description,
+	}
+
+	request, err := d.client.NewRequest(http.MethodPut, fmt.Sprintf("deploy/project/%d/environment", deploymentProjectID), environment)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &DeployEnvironment{}
+	response, err := d.client.Do(request, result)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.StatusCode != http.StatusOK && response.StatusCode != http.StatusCreated {
+		return nil, newRespErr(response, "Error adding environment")
+	}
+
+	return result, nil
+}
+
+// UpdateEnvironment renames the given environment and replaces its description
+func (d *DeployService) UpdateEnvironment(environmentID int, name, description string) (*DeployEnvironment, error) {
+	if emptyStrings(name) {
+		return nil, &simpleError{"Environment name cannot be empty"}
+	}
+
+	environment := &environmentRequest{
+		Name:        name,
+		Description: description,
+	}
+
+	request, err := d.client.NewRequest(http.MethodPost, fmt.Sprintf("deploy/environment/%d", environmentID), environment)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &DeployEnvironment{}
+	response, err := d.client.Do(request, result)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.StatusCode == http.StatusNotFound {
+		return nil, ErrNotFound
+	}
+
+	if response.StatusCode != http.StatusOK {
+		return nil, newRespErr(response, "Error updating environment")
+	}
+
+	return result, nil
+}
+
+// MoveEnvironment moves the given environment to the given zero based position
+// in the environment list of its deployment project
+func (d *DeployService) MoveEnvironment(environmentID, position int) error {
+	if position < 0 {
+		return &simpleError{fmt.Sprintf("Environment position %d cannot be negative", position)}
+	}
+
+	request, err := d.client.NewRequest(http.MethodPost, fmt.Sprintf("deploy/environment/%d/move", environmentID), nil)
+	if err != nil {
+		return err
+	}
+
+	values := request.URL.Query()
+	values.Set("position", strconv.Itoa(position))
+	request.URL.RawQuery = values.Encode()
+
+	response, err := d.client.Do(request, nil)
+	if err != nil {
+		return err
+	}
+
+	if response.StatusCode != http.StatusOK && response.StatusCode != http.StatusNoContent {
+		return newRespErr(response, "Error moving environment")
+	}
+
+	return nil
+}
+
+// DeleteEnvironment deletes the given environment along with its deployment results
+func (d *DeployService) DeleteEnvironment(environmentID int) error {
+	request, err := d.client.NewRequest(http.MethodDelete, fmt.Sprintf("deploy/environment/%d", environmentID), nil)
+	if err != nil {
+		return err
+	}
+
+	response, err := d.client.Do(request, nil)
+	if err != nil {
+		return err
+	}
+
+	if response.StatusCode != http.StatusOK && response.StatusCode != http.StatusNoContent {
+		return newRespErr(response, "Error deleting environment")
+	}
+
+	return nil
+}
+
+// EnvironmentTasks returns the tasks of the given environment in the order they run
+func (d *DeployService) EnvironmentTasks(environmentID int) ([]*EnvironmentTask, error) {
+	request, err := d.client.NewRequest(http.MethodGet, fmt.Sprintf("deploy/environment/%d/tasks", environmentID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	tasks := &environmentTasksResponse{}
+	response, err := d.client.Do(request, tasks)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.StatusCode == http.StatusNotFound {
+		return nil, ErrNotFound
+	}
+
+	if response.StatusCode != http.StatusOK {
+		return nil, newRespErr(response, "Error listing environment tasks")
+	}
+
+	return tasks.Tasks, nil
+}
+
 // DeployEnvironmentResults returns result information for the requested environment
 func (d *DeployService) DeployEnvironmentResults(id int) (*DeployEnvironmentResults, error) {
 	request, err := d.client.NewRequest(http.MethodGet, fmt.Sprintf("deploy/environment/%d/results", id), nil)
@@ -167,13 +730,184 @@ func (d *DeployService) DeployEnvironmentResults(id int) (*DeployEnvironmentResu
 	return deployEnvironmentResultsResp, nil
 }
 
+// ListDeploymentResults returns the deployments to the given environment, newest first.
+// A nil page returns every deployment, requesting them one page at a time.
+func (d *DeployService) ListDeploymentResults(environmentID int, page *Pagination) ([]*DeployStatus, error) {
+	if page != nil {
+		results, err := d.deploymentResultsPage(environmentID, *page)
+		if err != nil {
+			return nil, err
+		}
+		return results.Results, nil
+	}
+
+	resultList := []*DeployStatus{}
+	next := Pagination{Limit: defaultPageSize}
+	for {
+		results, err := d.deploymentResultsPage(environmentID, next)
+		if err != nil {
+			return nil, err
+		}
+
+		resultList = append(resultList, results.Results...)
+		next.Start += len(results.Results)
+		if len(results.Results) == 0 || !results.hasMore(next.Start) {
+			return resultList, nil
+		}
+	}
+}
+
+func (d *DeployService) deploymentResultsPage(environmentID int, page Pagination) (*DeployEnvironmentResults, error) {
+	request, err := d.client.NewRequest(http.MethodGet, fmt.Sprintf("deploy/environment/%d/results", environmentID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	values := request.URL.Query()
+	page.setIndexQuery(values)
+	request.URL.RawQuery = values.Encode()
+
+	results := &DeployEnvironmentResults{}
+	response, err := d.client.Do(request, results)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.StatusCode != http.StatusOK {
+		return nil, newRespErr(response, "Error listing deployment results")
+	}
+
+	return results, nil
+}
+
+// GetDeploymentResult returns the given deployment result including its full log
+func (d *DeployService) GetDeploymentResult(deploymentResultID int) (*DeploymentResult, error) {
+	var result *DeploymentResult
+	err := d.eachDeploymentLogPage(deploymentResultID, func(page *DeploymentResult) error {
+		if result == nil {
+			result = page
+			return nil
+		}
+		result.LogEntries.EntryList = append(result.LogEntries.EntryList, page.LogEntries.EntryList...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// DownloadDeploymentLog writes the plain text log of the given deployment result to w,
+// one page of log entries at a time
+func (d *DeployService) DownloadDeploymentLog(deploymentResultID int, w io.Writer) error {
+	if w == nil {
+		return &simpleError{"Writer cannot be nil"}
+	}
+
+	return d.eachDeploymentLogPage(deploymentResultID, func(page *DeploymentResult) error {
+		if page.LogEntries == nil {
+			return nil
+		}
+
+		for _, entry := range page.LogEntries.EntryList {
+			if _, err := io.WriteString(w, entry.UnstyledLog+"\n"); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// eachDeploymentLogPage calls fn with the given deployment result for every page of its log entries
+func (d *DeployService) eachDeploymentLogPage(deploymentResultID int, fn func(*DeploymentResult) error) error {
+	next := Pagination{Limit: defaultPageSize}
+	for {
+		result, err := d.deploymentResultPage(deploymentResultID, next)
+		if err != nil {
+			return err
+		}
+
+		if err := fn(result); err != nil {
+			return err
+		}
+
+		if result.LogEntries == nil {
+			return nil
+		}
+		next.Start += len(result.LogEntries.EntryList)
+		if len(result.LogEntries.EntryList) == 0 || !result.LogEntries.hasMore(next.Start) {
+			return nil
+		}
+	}
+}
+
+func (d *DeployService) deploymentResultPage(deploymentResultID int, page Pagination) (*DeploymentResult, error) {
+	request, err := d.client.NewRequest(http.MethodGet, fmt.Sprintf("deploy/result/%d", deploymentResultID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	values := request.URL.Query()
+	values.Set("includeLogs", "true")
+	page.setIndexQuery(values)
+	request.URL.RawQuery = values.Encode()
+
+	result := &DeploymentResult{}
+	response, err := d.client.Do(request, result)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.StatusCode == http.StatusNotFound {
+		return nil, ErrNotFound
+	}
+
+	if response.StatusCode != http.StatusOK {
+		return nil, newRespErr(response, "Error getting deployment result")
+	}
+
+	return result, nil
+}
+
+// QueueDeploymentOptions specifies the optional parameters
+// for the QueueDeployment method
+// - Variables: Variables to override for the deployment, keyed without the "bamboo.variable." prefix
+type QueueDeploymentOptions struct {
+	Variables map[string]string
+}
+
 // QueueDeploy adds a deploy of the specified version to the given environment.
 func (d *DeployService) QueueDeploy(environmentID, versionID int) (*QueueDeployRequest, error) {
-	request, err := d.client.NewRequest(http.MethodPost, fmt.Sprintf("queue/deployment/?environmentId=%d&versionId=%d", environmentID, versionID), nil)
+	return d.queueDeploy(environmentID, versionID, nil)
+}
+
+// QueueDeployment deploys the given release to the given environment and returns the ID of the deployment result
+func (d *DeployService) QueueDeployment(environmentID, versionID int, options *QueueDeploymentOptions) (int, error) {
+	queueDeployRequest, err := d.queueDeploy(environmentID, versionID, options)
+	if err != nil {
+		return 0, err
+	}
+
+	return queueDeployRequest.DeploymentResultID, nil
+}
+
+func (d *DeployService) queueDeploy(environmentID, versionID int, options *QueueDeploymentOptions) (*QueueDeployRequest, error) {
+	request, err := d.client.NewRequest(http.MethodPost, "queue/deployment/", nil)
 	if err != nil {
 		return nil, err
 	}
 
+	values := request.URL.Query()
+	values.Set("environmentId", strconv.Itoa(environmentID))
+	values.Set("versionId", strconv.Itoa(versionID))
+	if options != nil {
+		for name, value := range options.Variables {
+			values.Set("bamboo.variable."+name, value)
+		}
+	}
+	request.URL.RawQuery = values.Encode()
+
 	queueDeployRequest := &QueueDeployRequest{}
 	response, err := d.client.Do(request, &queueDeployRequest)
 	if err != nil {
@@ -187,22 +921,93 @@ func (d *DeployService) QueueDeploy(environmentID, versionID int) (*QueueDeployR
 	return queueDeployRequest, nil
 }
 
+// PreviewDeployment returns the commits, JIRA issues and artifacts a deployment of the given
+// release to the given environment would ship, without deploying it
+func (d *DeployService) PreviewDeployment(environmentID, versionID int) (*DeploymentPreview, error) {
+	request, err := d.client.NewRequest(http.MethodGet, "deploy/preview/version", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	values := request.URL.Query()
+	values.Set("environmentId", strconv.Itoa(environmentID))
+	values.Set("versionId", strconv.Itoa(versionID))
+	request.URL.RawQuery = values.Encode()
+
+	preview := &DeploymentPreview{}
+	response, err := d.client.Do(request, preview)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.StatusCode != http.StatusOK {
+		return nil, newRespErr(response, "Error previewing deployment")
+	}
+
+	return preview, nil
+}
+
+// StopDeployment stops the given queued or running deployment
+func (d *DeployService) StopDeployment(deploymentResultID int) error {
+	request, err := d.client.NewRequest(http.MethodDelete, fmt.Sprintf("queue/deployment/%d", deploymentResultID), nil)
+	if err != nil {
+		return err
+	}
+
+	response, err := d.client.Do(request, nil)
+	if err != nil {
+		return err
+	}
+
+	if response.StatusCode != http.StatusOK && response.StatusCode != http.StatusNoContent {
+		return newRespErr(response, "Error stopping deployment")
+	}
+
+	return nil
+}
+
+// Dashboard returns the latest deployment to each environment of every deployment project
+func (d *DeployService) Dashboard() ([]*DeploymentDashboard, error) {
+	request, err := d.client.NewRequest(http.MethodGet, "deploy/dashboard", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	dashboard := []*DeploymentDashboard{}
+	response, err := d.client.Do(request, &dashboard)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.StatusCode != http.StatusOK {
+		return nil, newRespErr(response, "Error getting deployment dashboard")
+	}
+
+	return dashboard, nil
+}
+
 // DeployStatus returns information on the requested deploy
 func (d *DeployService) DeployStatus(id int) (*DeployStatus, error) {
+	deployStatus, _, err := d.deployStatus(context.Background(), id)
+	return deployStatus, err
+}
+
+func (d *DeployService) deployStatus(ctx context.Context, id int) (*DeployStatus, *http.Response, error) {
 	request, err := d.client.NewRequest(http.MethodGet, fmt.Sprintf("deploy/result/%d", id), nil)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
+	request = request.WithContext(ctx)
 
 	deployStatus := &DeployStatus{}
 	response, err := d.client.Do(request, &deployStatus)
 	if err != nil {
-		return nil, err
+		return nil, response, err
 	}
 
 	if response.StatusCode != http.StatusOK {
-		return nil, newRespErr(response, "Error getting deploy status")
+		return nil, response, newRespErr(response, "Error getting deploy status")
 	}
 
-	return deployStatus, nil
+	return deployStatus, response, nil
 }
@@ -0,0 +1,474 @@
+package bamboo_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	bamboo "github.com/sukhyun/go-bamboo"
+)
+
+func TestGetDeploymentProject(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(getDeploymentProjectStub))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	deploy, err := client.Deploys.GetDeploymentProject(65)
+	assert.NoError(t, err)
+	assert.Equal(t, "Core deployment", deploy.Name)
+	assert.Equal(t, "CORE-TEST", deploy.PlanKey.Key)
+	assert.True(t, deploy.Operations.CanEdit)
+	if assert.Len(t, deploy.Environments, 1) {
+		assert.True(t, deploy.Environments[0].Operations.CanExecute)
+	}
+
+	_, err = client.Deploys.GetDeploymentProject(66)
+	assert.Equal(t, bamboo.ErrNotFound, err)
+}
+
+func getDeploymentProjectStub(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/rest/api/latest/deploy/project/65" {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	w.Write([]byte(`{"id":65,"name":"Core deployment","planKey":{"key":"CORE-TEST"},
+		"operations":{"canView":true,"canEdit":true,"canDelete":false},
+		"environments":[{"id":131,"name":"Production","deploymentProjectId":65,"operations":{"canView":true,"canExecute":true}}]}`))
+}
+
+func TestDeploymentProjectsForPlan(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/latest/deploy/project/forPlan" || r.URL.Query().Get("planKey") != "CORE-TEST" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Write([]byte(`[{"id":65,"name":"Core deployment"},{"id":66,"name":"Core docs"}]`))
+	}))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	deploys, err := client.Deploys.DeploymentProjectsForPlan("CORE-TEST")
+	assert.NoError(t, err)
+	if assert.Len(t, deploys, 2) {
+		assert.Equal(t, 66, deploys[1].ID)
+	}
+}
+
+func TestCreateDeploymentProject(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(createDeploymentProjectStub))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	deploy, err := client.Deploys.CreateDeploymentProject("Core deployment", "CORE-TEST", "Deploys core")
+	assert.NoError(t, err)
+	assert.Equal(t, 67, deploy.ID)
+
+	_, err = client.Deploys.CreateDeploymentProject("", "CORE-TEST", "")
+	assert.Error(t, err)
+}
+
+func createDeploymentProjectStub(w http.ResponseWriter, r *http.Request) {
+	body := map[string]interface{}{}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil || r.Method != http.MethodPut ||
+		r.URL.Path != "/rest/api/latest/deploy/project" || body["name"] != "Core deployment" ||
+		body["planKey"].(map[string]interface{})["key"] != "CORE-TEST" {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	w.Write([]byte(`{"id":67,"name":"Core deployment","description":"Deploys core","planKey":{"key":"CORE-TEST"}}`))
+}
+
+func TestUpdateAndDeleteDeploymentProject(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/rest/api/latest/deploy/project/67":
+			w.Write([]byte(`{"id":67,"name":"Core release","planKey":{"key":"CORE-MAIN"}}`))
+		case r.Method == http.MethodDelete && r.URL.Path == "/rest/api/latest/deploy/project/67":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	deploy, err := client.Deploys.UpdateDeploymentProject(67, "Core release", "CORE-MAIN", "")
+	assert.NoError(t, err)
+	assert.Equal(t, "CORE-MAIN", deploy.PlanKey.Key)
+
+	assert.NoError(t, client.Deploys.DeleteDeploymentProject(67))
+	assert.Error(t, client.Deploys.DeleteDeploymentProject(68))
+}
+
+func TestAddEnvironment(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body := map[string]string{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil || r.Method != http.MethodPut ||
+			r.URL.Path != "/rest/api/latest/deploy/project/67/environment" || body["name"] != "Staging" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Write([]byte(`{"id":132,"name":"Staging","deploymentProjectId":67}`))
+	}))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	environment, err := client.Deploys.AddEnvironment(67, "Staging", "Pre-production checks")
+	assert.NoError(t, err)
+	assert.Equal(t, 132, environment.ID)
+	assert.Equal(t, 67, environment.DeploymentProjectID)
+}
+
+func TestManageEnvironment(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/rest/api/latest/deploy/environment/132":
+			w.Write([]byte(`{"id":132,"name":"QA","deploymentProjectId":67}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/rest/api/latest/deploy/environment/132/move" && r.URL.Query().Get("position") == "0":
+			w.WriteHeader(http.StatusNoContent)
+		case r.Method == http.MethodDelete && r.URL.Path == "/rest/api/latest/deploy/environment/132":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	environment, err := client.Deploys.UpdateEnvironment(132, "QA", "")
+	assert.NoError(t, err)
+	assert.Equal(t, "QA", environment.Name)
+
+	assert.NoError(t, client.Deploys.MoveEnvironment(132, 0))
+	assert.Error(t, client.Deploys.MoveEnvironment(132, -1))
+	assert.NoError(t, client.Deploys.DeleteEnvironment(132))
+}
+
+func TestCreateVersion(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body := map[string]string{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil || r.Method != http.MethodPost ||
+			r.URL.Path != "/rest/api/latest/deploy/project/65/version" || body["planResultKey"] != "CORE-TEST-7" || body["name"] != "release-1.4" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if _, ok := body["nextVersionName"]; ok {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Write([]byte(`{"id":1015,"name":"release-1.4"}`))
+	}))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	version, err := client.Deploys.CreateVersion(65, "CORE-TEST-7", "release-1.4")
+	assert.NoError(t, err)
+	assert.Equal(t, 1015, version.ID)
+
+	_, err = client.Deploys.CreateVersion(65, "CORE-TEST", "release-1.4")
+	assert.Error(t, err)
+}
+
+func TestListVersions(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(listVersionsStub))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	versions, err := client.Deploys.ListVersions(65, &bamboo.DeployVersionListOptions{BranchKey: "CORE-TEST3"})
+	assert.NoError(t, err)
+	if assert.Len(t, versions, 3) {
+		assert.Equal(t, "release-1.2", versions[2].Name)
+		assert.Equal(t, "feature-login", versions[2].PlanBranchName)
+	}
+
+	page := &bamboo.DeployVersionListOptions{Page: &bamboo.Pagination{Start: 2, Limit: 2}, BranchKey: "CORE-TEST3"}
+	versions, err = client.Deploys.ListVersions(65, page)
+	assert.NoError(t, err)
+	assert.Len(t, versions, 1)
+}
+
+func listVersionsStub(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	if r.URL.Path != "/rest/api/latest/deploy/project/65/versions" || q.Get("branchKey") != "CORE-TEST3" {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	all := []*bamboo.DeployVersionResult{
+		&bamboo.DeployVersionResult{ID: 3, Name: "release-1.4", PlanBranchName: "feature-login"},
+		&bamboo.DeployVersionResult{ID: 2, Name: "release-1.3", PlanBranchName: "feature-login"},
+		&bamboo.DeployVersionResult{ID: 1, Name: "release-1.2", PlanBranchName: "feature-login"},
+	}
+	start, _ := strconv.Atoi(q.Get("start-index"))
+	limit, _ := strconv.Atoi(q.Get("max-results"))
+	end := start + limit
+	if end > len(all) {
+		end = len(all)
+	}
+
+	resp := bamboo.DeployVersionListResult{
+		CollectionMetadata: &bamboo.CollectionMetadata{Size: len(all), StartIndex: start, MaxResult: limit},
+		Versions:           all[start:end],
+	}
+	bytes, err := json.Marshal(resp)
+	if err != nil {
+		panic(err)
+	}
+
+	w.Write(bytes)
+}
+
+func TestUpdateAndDeleteVersion(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/rest/api/latest/deploy/version/1015":
+			body := map[string]interface{}{}
+			json.NewDecoder(r.Body).Decode(&body)
+			w.Write([]byte(fmt.Sprintf(`{"id":1015,"name":%q}`, body["name"])))
+		case r.Method == http.MethodDelete && r.URL.Path == "/rest/api/latest/deploy/version/1015":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	version, err := client.Deploys.UpdateVersion(1015, "release-1.4.1")
+	assert.NoError(t, err)
+	assert.Equal(t, "release-1.4.1", version.Name)
+
+	_, err = client.Deploys.UpdateVersion(1016, "release-1.4.1")
+	assert.Equal(t, bamboo.ErrNotFound, err)
+
+	assert.NoError(t, client.Deploys.DeleteVersion(1015))
+}
+
+func TestQueueDeployment(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if r.Method != http.MethodPost || r.URL.Path != "/rest/api/latest/queue/deployment/" ||
+			q.Get("environmentId") != "131" || q.Get("versionId") != "1015" || q.Get("bamboo.variable.canary") != "true" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Write([]byte(`{"deploymentResultId":2049,"link":{"href":"http://bamboo/rest/api/latest/deploy/result/2049","rel":"self"}}`))
+	}))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	options := &bamboo.QueueDeploymentOptions{Variables: map[string]string{"canary": "true"}}
+	id, err := client.Deploys.QueueDeployment(131, 1015, options)
+	assert.NoError(t, err)
+	assert.Equal(t, 2049, id)
+
+	_, err = client.Deploys.QueueDeploy(131, 1015)
+	assert.Error(t, err)
+}
+
+func TestListDeploymentResults(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/latest/deploy/environment/131/results" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if r.URL.Query().Get("start-index") != "" {
+			w.Write([]byte(`{"size":2,"start-index":1,"max-result":1,"results":[
+				{"id":2048,"deploymentVersionName":"release-1.3","deploymentState":"FAILED","lifeCycleState":"FINISHED","startedDate":1577955600000,"finishedDate":1577955660000}]}`))
+			return
+		}
+		w.Write([]byte(`{"size":2,"start-index":0,"max-result":1,"results":[
+			{"id":2049,"deploymentVersionName":"release-1.4","deploymentState":"UNKNOWN","lifeCycleState":"IN_PROGRESS","startedDate":1577959200000,"reasonSummary":"Manual run by jdoe"}]}`))
+	}))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	results, err := client.Deploys.ListDeploymentResults(131, nil)
+	assert.NoError(t, err)
+	if assert.Len(t, results, 2) {
+		assert.Equal(t, "Manual run by jdoe", results[0].ReasonSummary)
+		assert.True(t, results[0].Finished().IsZero())
+		assert.Equal(t, time.Minute, results[1].Finished().Sub(results[1].Started()))
+	}
+}
+
+func TestDownloadDeploymentLog(t *testing.T) {
+	// The server pages the log entries one at a time, regardless of max-results
+	entries := []string{
+		`{"log":"<b>Deploying</b> release-1.3","unstyledLog":"Deploying release-1.3","date":1577955600000}`,
+		`{"log":"Task failed","unstyledLog":"Task failed","date":1577955660000}`,
+	}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if r.URL.Path != "/rest/api/latest/deploy/result/2048" || query.Get("includeLogs") != "true" || query.Get("max-results") != "100" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		start, _ := strconv.Atoi(query.Get("start-index"))
+		w.Write([]byte(fmt.Sprintf(`{"id":2048,"deploymentState":"FAILED","lifeCycleState":"FINISHED","logEntries":{"size":2,"start-index":%d,"logEntry":[%s]}}`,
+			start, entries[start])))
+	}))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	result, err := client.Deploys.GetDeploymentResult(2048)
+	assert.NoError(t, err)
+	assert.Equal(t, "FAILED", result.DeploymentState)
+	assert.Len(t, result.LogEntries.EntryList, 2)
+
+	buf := &bytes.Buffer{}
+	assert.NoError(t, client.Deploys.DownloadDeploymentLog(2048, buf))
+	assert.Equal(t, "Deploying release-1.3\nTask failed\n", buf.String())
+
+	assert.Equal(t, bamboo.ErrNotFound, client.Deploys.DownloadDeploymentLog(2047, buf))
+}
+
+func TestStopDeployment(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/rest/api/latest/queue/deployment/2049" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	assert.NoError(t, client.Deploys.StopDeployment(2049))
+	assert.Error(t, client.Deploys.StopDeployment(2048))
+}
+
+func TestDashboard(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/latest/deploy/dashboard" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(`[{"deploymentProject":{"id":65,"name":"Core deployment","planKey":{"key":"CORE-TEST"}},"environmentStatuses":[
+			{"environment":{"id":131,"name":"Production"},"deploymentResult":{"id":2048,"deploymentVersionName":"release-1.3","deploymentState":"SUCCESS","lifeCycleState":"FINISHED"}},
+			{"environment":{"id":132,"name":"Staging"}}]}]`))
+	}))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	dashboard, err := client.Deploys.Dashboard()
+	assert.NoError(t, err)
+	if assert.Len(t, dashboard, 1) && assert.Len(t, dashboard[0].EnvironmentStatuses, 2) {
+		assert.Equal(t, "Core deployment", dashboard[0].DeploymentProject.Name)
+		assert.Equal(t, "release-1.3", dashboard[0].EnvironmentStatuses[0].DeploymentResult.DeploymentVersionName)
+		assert.Nil(t, dashboard[0].EnvironmentStatuses[1].DeploymentResult)
+	}
+}
+
+func TestEnvironmentTasks(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/latest/deploy/environment/131/tasks" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(`{"tasks":[
+			{"id":1,"pluginKey":"com.atlassian.bamboo.plugins.bamboo-artifact-downloader-plugin:cleanWorkingDirectoryTask","userDescription":"Clean","isEnabled":true,"isFinal":false},
+			{"id":2,"pluginKey":"com.atlassian.bamboo.plugins.scripttask:task.builder.script","userDescription":"Create change record","isEnabled":false,"isFinal":true}]}`))
+	}))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	tasks, err := client.Deploys.EnvironmentTasks(131)
+	assert.NoError(t, err)
+	if assert.Len(t, tasks, 2) {
+		assert.Equal(t, "Create change record", tasks[1].Description)
+		assert.False(t, tasks[1].Enabled)
+		assert.True(t, tasks[1].Final)
+	}
+
+	_, err = client.Deploys.EnvironmentTasks(132)
+	assert.Equal(t, bamboo.ErrNotFound, err)
+}
+
+func TestPreviewDeployment(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if r.URL.Path != "/rest/api/latest/deploy/preview/version" || q.Get("environmentId") != "131" || q.Get("versionId") != "1015" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Write([]byte(`{"currentVersion":{"id":1014,"name":"release-1.3"},"version":{"id":1015,"name":"release-1.4"},
+			"changes":{"change":[{"author":"jdoe","changesetId":"4b825dc6","comment":"Fix login"}]},
+			"jiraIssues":{"size":1,"issue":[{"key":"CORE-12","summary":"Login fails"}]},
+			"artifacts":{"size":1,"artifact":[{"name":"core.jar","shared":true,"size":1024}]}}`))
+	}))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	preview, err := client.Deploys.PreviewDeployment(131, 1015)
+	assert.NoError(t, err)
+	assert.Equal(t, "release-1.3", preview.CurrentVersion.Name)
+	assert.Equal(t, "4b825dc6", preview.Changes.Set[0].ChangeSetID)
+	assert.Equal(t, "CORE-12", preview.JiraIssues.IssueList[0].Key)
+	assert.Equal(t, "core.jar", preview.Artifacts.ArtifactList[0].Name)
+}
+
+func TestVersionStatus(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/latest/deploy/version/1015/status" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(`[{"environment":{"id":132,"name":"Staging"},"deploymentResult":{"id":2050,"deploymentState":"SUCCESS","lifeCycleState":"FINISHED"}},
+			{"environment":{"id":133,"name":"QA"},"deploymentResult":{"id":2051,"deploymentState":"FAILED","lifeCycleState":"FINISHED"}}]`))
+	}))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	statuses, err := client.Deploys.VersionStatus(1015)
+	assert.NoError(t, err)
+	assert.Len(t, statuses, 2)
+	assert.True(t, statuses.Succeeded(132))
+	assert.False(t, statuses.Succeeded(133))
+	assert.False(t, statuses.Succeeded(131))
+
+	_, err = client.Deploys.VersionStatus(1016)
+	assert.Equal(t, bamboo.ErrNotFound, err)
+}
@@ -0,0 +1,129 @@
+package bamboo
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// PendingInstanceState is the state of an elastic instance which is starting
+const PendingInstanceState string = "PENDING"
+
+// RunningInstanceState is the state of an elastic instance which is running
+const RunningInstanceState string = "RUNNING"
+
+// ShuttingDownInstanceState is the state of an elastic instance which is stopping
+const ShuttingDownInstanceState string = "SHUTTING_DOWN"
+
+// ElasticInstance is an elastic agent instance running in the cloud
+// - ConfigurationID: The elastic image configuration the instance was started from
+// - AgentID:         The agent running on the instance, zero until the agent has connected
+type ElasticInstance struct {
+	ID                string `json:"instanceId"`
+	ConfigurationID   int64  `json:"configurationId"`
+	ConfigurationName string `json:"configurationName,omitempty"`
+	State             string `json:"state"`
+	AgentID           int64  `json:"agentId,omitempty"`
+	StartedTime       string `json:"startedTime,omitempty"`
+}
+
+// ListElasticInstances returns the elastic instances the server is running
+func (a *AgentService) ListElasticInstances() ([]*ElasticInstance, *http.Response, error) {
+	request, err := a.client.NewRequest(http.MethodGet, "elasticInstances", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	instances := []*ElasticInstance{}
+	response, err := a.client.Do(request, &instances)
+	if err != nil {
+		return nil, response, err
+	}
+
+	if response.StatusCode != 200 {
+		return nil, response, &simpleError{fmt.Sprintf("Listing elastic instances returned %s", response.Status)}
+	}
+
+	return instances, response, nil
+}
+
+// GetElasticInstance returns the given elastic instance
+func (a *AgentService) GetElasticInstance(instanceID string) (*ElasticInstance, *http.Response, error) {
+	if emptyStrings(instanceID) {
+		return nil, nil, &simpleError{"Instance ID cannot be empty"}
+	}
+
+	request, err := a.client.NewRequest(http.MethodGet, fmt.Sprintf("elasticInstances/instance/%s", url.PathEscape(instanceID)), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	instance := ElasticInstance{}
+	response, err := a.client.Do(request, &instance)
+	if err != nil {
+		return nil, response, err
+	}
+
+	if response.StatusCode == 404 {
+		return nil, response, ErrNotFound
+	}
+
+	if response.StatusCode != 200 {
+		return nil, response, &simpleError{fmt.Sprintf("Getting elastic instance %s returned %s", instanceID, response.Status)}
+	}
+
+	return &instance, response, nil
+}
+
+// StartElasticInstances starts count instances of the given elastic image configuration
+func (a *AgentService) StartElasticInstances(configurationID int64, count int) ([]*ElasticInstance, *http.Response, error) {
+	if count < 1 {
+		return nil, nil, &simpleError{fmt.Sprintf("Can't start %d elastic instances", count)}
+	}
+
+	request, err := a.client.NewRequest(http.MethodPost, "elasticInstances", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	values := request.URL.Query()
+	values.Set("configurationId", strconv.FormatInt(configurationID, 10))
+	values.Set("instancesToStart", strconv.Itoa(count))
+	request.URL.RawQuery = values.Encode()
+
+	instances := []*ElasticInstance{}
+	response, err := a.client.Do(request, &instances)
+	if err != nil {
+		return nil, response, err
+	}
+
+	if response.StatusCode != 200 {
+		return nil, response, &simpleError{fmt.Sprintf("Starting elastic instances returned %s", response.Status)}
+	}
+
+	return instances, response, nil
+}
+
+// StopElasticInstance shuts down the given elastic instance along with its agent
+func (a *AgentService) StopElasticInstance(instanceID string) (*http.Response, error) {
+	if emptyStrings(instanceID) {
+		return nil, &simpleError{"Instance ID cannot be empty"}
+	}
+
+	request, err := a.client.NewRequest(http.MethodDelete, fmt.Sprintf("elasticInstances/instance/%s", url.PathEscape(instanceID)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := a.client.Do(request, nil)
+	if err != nil {
+		return response, err
+	}
+
+	if response.StatusCode != 200 && response.StatusCode != 204 {
+		return response, &simpleError{fmt.Sprintf("Stopping elastic instance %s returned %s", instanceID, response.Status)}
+	}
+
+	return response, nil
+}
@@ -0,0 +1,55 @@
+package bamboo_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	bamboo "github.com/sukhyun/go-bamboo"
+)
+
+func TestElasticInstances(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(elasticInstancesStub))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	instances, _, err := client.Agents.ListElasticInstances()
+	assert.NoError(t, err)
+	if assert.Len(t, instances, 1) {
+		assert.Equal(t, bamboo.RunningInstanceState, instances[0].State)
+	}
+
+	instance, _, err := client.Agents.GetElasticInstance("i-0a1b2c3d")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(229377), instance.AgentID)
+
+	started, _, err := client.Agents.StartElasticInstances(3, 2)
+	assert.NoError(t, err)
+	assert.Len(t, started, 2)
+
+	_, err = client.Agents.StopElasticInstance("i-0a1b2c3d")
+	assert.NoError(t, err)
+
+	_, _, err = client.Agents.StartElasticInstances(3, 0)
+	assert.Error(t, err)
+}
+
+func elasticInstancesStub(w http.ResponseWriter, r *http.Request) {
+	instance := `{"instanceId":"i-0a1b2c3d","configurationId":3,"configurationName":"Ubuntu","state":"RUNNING","agentId":229377}`
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == "/rest/api/latest/elasticInstances":
+		w.Write([]byte("[" + instance + "]"))
+	case r.Method == http.MethodGet && r.URL.Path == "/rest/api/latest/elasticInstances/instance/i-0a1b2c3d":
+		w.Write([]byte(instance))
+	case r.Method == http.MethodPost && r.URL.Path == "/rest/api/latest/elasticInstances" &&
+		r.URL.Query().Get("configurationId") == "3" && r.URL.Query().Get("instancesToStart") == "2":
+		w.Write([]byte(`[{"instanceId":"i-1","configurationId":3,"state":"PENDING"},{"instanceId":"i-2","configurationId":3,"state":"PENDING"}]`))
+	case r.Method == http.MethodDelete && r.URL.Path == "/rest/api/latest/elasticInstances/instance/i-0a1b2c3d":
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusBadRequest)
+	}
+}
@@ -1,5 +1,10 @@
 package bamboo
 
+import "errors"
+
+// ErrNotFound is returned when a requested resource does not exist on the Bamboo server
+var ErrNotFound = errors.New("Resource not found")
+
 type simpleError struct {
 	message string
 }
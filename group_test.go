@@ -14,7 +14,7 @@ func TestGroupPermissionsList(t *testing.T) {
 	ts := httptest.NewServer(http.HandlerFunc(groupPermissionsListStub))
 	defer ts.Close()
 
-	client := bamboo.NewSimpleClient(nil, "", "")
+	client := bamboo.NewSimpleClient(nil, "", "", "")
 	client.SetURL(ts.URL)
 
 	for _, tc := range permissionsTestCases {
@@ -49,7 +49,7 @@ func TestGroupPermissions(t *testing.T) {
 	ts := httptest.NewServer(http.HandlerFunc(groupPermissionsStub))
 	defer ts.Close()
 
-	client := bamboo.NewSimpleClient(nil, "", "")
+	client := bamboo.NewSimpleClient(nil, "", "", "")
 	client.SetURL(ts.URL)
 
 	for _, tc := range permissionsTestCases {
@@ -84,7 +84,7 @@ func TestSetGroupPermissions(t *testing.T) {
 	ts := httptest.NewServer(http.HandlerFunc(setGroupPermissionsStub))
 	defer ts.Close()
 
-	client := bamboo.NewSimpleClient(nil, "", "")
+	client := bamboo.NewSimpleClient(nil, "", "", "")
 	client.SetURL(ts.URL)
 
 	for _, tc := range permissionsTestCases {
@@ -119,7 +119,7 @@ func TestRemoveGroupPermissions(t *testing.T) {
 	ts := httptest.NewServer(http.HandlerFunc(removeGroupPermissionsStub))
 	defer ts.Close()
 
-	client := bamboo.NewSimpleClient(nil, "", "")
+	client := bamboo.NewSimpleClient(nil, "", "", "")
 	client.SetURL(ts.URL)
 
 	for _, tc := range permissionsTestCases {
@@ -154,7 +154,7 @@ func TestAvailableGroupsPermissionsList(t *testing.T) {
 	ts := httptest.NewServer(http.HandlerFunc(availableGroupsPermissionsListStub))
 	defer ts.Close()
 
-	client := bamboo.NewSimpleClient(nil, "", "")
+	client := bamboo.NewSimpleClient(nil, "", "", "")
 	client.SetURL(ts.URL)
 
 	for _, tc := range permissionsTestCases {
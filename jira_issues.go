@@ -0,0 +1,54 @@
+package bamboo
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// JiraIssues is the collection of JIRA issues linked to a build result
+type JiraIssues struct {
+	*CollectionMetadata
+	IssueList []*JiraIssue `json:"issue"`
+}
+
+// JiraIssue is a JIRA issue linked to a build result
+// - Type:   The issue type, e.g. "Bug"
+// - Status: The issue status, only set when Bamboo can reach JIRA
+type JiraIssue struct {
+	Key     string `json:"key"`
+	Type    string `json:"issueType,omitempty"`
+	Summary string `json:"summary,omitempty"`
+	Status  string `json:"status,omitempty"`
+	URL     *Link  `json:"url,omitempty"`
+}
+
+// ResultJiraIssues returns the JIRA issues linked to the given result, e.g. "PROJ-PLAN-123"
+func (r *ResultService) ResultJiraIssues(resultKey string) ([]*JiraIssue, *http.Response, error) {
+	if emptyStrings(resultKey) {
+		return nil, nil, &simpleError{"Result key cannot be empty"}
+	}
+
+	request, err := r.client.NewRequest(http.MethodGet, fmt.Sprintf("%s/%s.json", resultsBase, resultKey), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	values := request.URL.Query()
+	values.Set("expand", "jiraIssues")
+	request.URL.RawQuery = values.Encode()
+
+	result := Result{}
+	response, err := r.client.Do(request, &result)
+	if err != nil {
+		return nil, response, err
+	}
+
+	if response.StatusCode != 200 {
+		return nil, response, &simpleError{fmt.Sprintf("Getting JIRA issues of %s returned %s", resultKey, response.Status)}
+	}
+
+	if result.JiraIssues == nil {
+		return []*JiraIssue{}, response, nil
+	}
+	return result.JiraIssues.IssueList, response, nil
+}
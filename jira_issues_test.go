@@ -0,0 +1,38 @@
+package bamboo_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	bamboo "github.com/sukhyun/go-bamboo"
+)
+
+func TestResultJiraIssues(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(resultJiraIssuesStub))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	issues, _, err := client.Results.ResultJiraIssues("CORE-TEST-7")
+	assert.NoError(t, err)
+	if assert.Len(t, issues, 2) {
+		assert.Equal(t, "CORE-12", issues[0].Key)
+		assert.Equal(t, "Bug", issues[0].Type)
+		assert.Equal(t, "Done", issues[0].Status)
+		assert.Equal(t, "https://jira.example.com/browse/CORE-12", issues[0].URL.HREF)
+	}
+}
+
+func resultJiraIssuesStub(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/rest/api/latest/result/CORE-TEST-7.json" || r.URL.Query().Get("expand") != "jiraIssues" {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	w.Write([]byte(`{"key":"CORE-TEST-7","jiraIssues":{"size":2,"issue":[
+		{"key":"CORE-12","issueType":"Bug","summary":"Login fails","status":"Done","url":{"href":"https://jira.example.com/browse/CORE-12","rel":"self"}},
+		{"key":"CORE-15","issueType":"Story","summary":"Remember me"}]}}`))
+}
@@ -3,6 +3,7 @@ package bamboo
 import (
 	"fmt"
 	"net/http"
+	"net/url"
 )
 
 // LabelService handles communication with the labels on a plan result
@@ -43,3 +44,68 @@ func (c *LabelService) AddLabel(label *Label) (bool, *http.Response, error) {
 
 	return true, response, nil
 }
+
+// Labels is the collection of labels on a result
+type Labels struct {
+	*CollectionMetadata
+	LabelList []*Label `json:"label"`
+}
+
+type labelsResponse struct {
+	Labels *Labels `json:"labels"`
+}
+
+// ListLabels returns the labels on the given result
+func (c *LabelService) ListLabels(resultKey string) ([]*Label, *http.Response, error) {
+	if emptyStrings(resultKey) {
+		return nil, nil, &simpleError{"Result key cannot be empty"}
+	}
+
+	request, err := c.client.NewRequest(http.MethodGet, fmt.Sprintf("result/%s/label.json", resultKey), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	data := labelsResponse{}
+	response, err := c.client.Do(request, &data)
+	if err != nil {
+		return nil, response, err
+	}
+
+	if !(response.StatusCode == 200) {
+		return nil, response, &simpleError{fmt.Sprintf("Listing labels of %s returned %s", resultKey, response.Status)}
+	}
+
+	if data.Labels == nil {
+		return []*Label{}, response, nil
+	}
+
+	for _, label := range data.Labels.LabelList {
+		label.ResultKey = resultKey
+	}
+	return data.Labels.LabelList, response, nil
+}
+
+// RemoveLabel will remove a label from the given result.
+func (c *LabelService) RemoveLabel(label *Label) (bool, *http.Response, error) {
+	if label == nil || label.isEmpty() {
+		return false, nil, &simpleError{"Label cannot be nil or empty"}
+	}
+	u := fmt.Sprintf("result/%s/label/%s", label.ResultKey, url.PathEscape(label.Name))
+
+	request, err := c.client.NewRequest(http.MethodDelete, u, nil)
+	if err != nil {
+		return false, nil, err
+	}
+
+	response, err := c.client.Do(request, nil)
+	if err != nil {
+		return false, response, err
+	}
+
+	if !(response.StatusCode == 204) {
+		return false, response, &simpleError{fmt.Sprintf("Removing Label from %s returned %s", label.ResultKey, response.Status)}
+	}
+
+	return true, response, nil
+}
@@ -19,7 +19,7 @@ func TestAddLabel(t *testing.T) {
 	ts := httptest.NewServer(http.HandlerFunc(addLabelStub))
 	defer ts.Close()
 
-	client := bamboo.NewSimpleClient(nil, "", "")
+	client := bamboo.NewSimpleClient(nil, "", "", "")
 	client.SetURL(ts.URL)
 
 	label := &bamboo.Label{
@@ -55,3 +55,101 @@ func addLabelStub(w http.ResponseWriter, r *http.Request) {
 
 	w.WriteHeader(http.StatusNoContent)
 }
+
+func TestListLabels(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(listLabelsStub))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	labels, _, err := client.Labels.ListLabels(resultLabelKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(labels) != 1 || labels[0].Name != testLabel || labels[0].ResultKey != resultLabelKey {
+		t.Errorf("Unexpected labels %+v", labels)
+	}
+}
+
+func listLabelsStub(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != fmt.Sprintf("/rest/api/latest/result/%s/label.json", resultLabelKey) {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	resp := map[string]*bamboo.Labels{
+		"labels": &bamboo.Labels{LabelList: []*bamboo.Label{&bamboo.Label{Name: testLabel}}},
+	}
+
+	bytes, err := json.Marshal(resp)
+	if err != nil {
+		panic(err)
+	}
+
+	w.Write(bytes)
+}
+
+func TestRemoveLabel(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(removeLabelStub))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	label := &bamboo.Label{
+		Name:      testLabel,
+		ResultKey: resultLabelKey,
+	}
+
+	success, resp, err := client.Labels.RemoveLabel(label)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if success == false || resp.StatusCode != 204 {
+		t.Error(fmt.Sprintf("Removing label \"%s\" was unsuccessful. Returned %s", testLabel, resp.Status))
+	}
+}
+
+func removeLabelStub(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodDelete || r.URL.Path != fmt.Sprintf("/rest/api/latest/result/%s/label/%s", resultLabelKey, testLabel) {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func TestSearchResultsByLabel(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(searchResultsByLabelStub))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	results, _, err := client.Results.SearchResultsByLabel(testLabel, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(results) != 1 || results[0].Key != resultLabelKey {
+		t.Errorf("Unexpected results %+v", results)
+	}
+}
+
+func searchResultsByLabelStub(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/rest/api/latest/result.json" || r.URL.Query().Get("label") != testLabel {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	resp := bamboo.ResultsResponse{Results: &bamboo.Results{ResultList: []*bamboo.Result{&bamboo.Result{Key: resultLabelKey}}}}
+	bytes, err := json.Marshal(resp)
+	if err != nil {
+		panic(err)
+	}
+
+	w.Write(bytes)
+}
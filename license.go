@@ -0,0 +1,60 @@
+package bamboo
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// unlimitedRemoteAgents is the number of remote agents allowed by a license without a limit
+const unlimitedRemoteAgents = -1
+
+// License holds the license details of the Bamboo server
+// - LicenseType:           e.g. "COMMERCIAL", "DATACENTER" or "EVALUATION"
+// - MaintenanceExpiryDate: When software maintenance of the license expires
+// - RemoteAgentsAllowed:   Number of remote agents the license allows, -1 if unlimited
+// - RemoteAgentsUsed:      Number of remote agents currently registered
+type License struct {
+	LicenseType           string `json:"licenseType"`
+	Description           string `json:"description"`
+	Expired               bool   `json:"expired"`
+	MaintenanceExpiryDate string `json:"maintenanceExpiryDate,omitempty"`
+	RemoteAgentsAllowed   int    `json:"remoteAgentsAllowed"`
+	RemoteAgentsUsed      int    `json:"remoteAgentsUsed"`
+}
+
+// UnlimitedRemoteAgents reports whether the license allows any number of remote agents
+func (l *License) UnlimitedRemoteAgents() bool {
+	return l.RemoteAgentsAllowed == unlimitedRemoteAgents
+}
+
+// RemoteAgentsAvailable returns how many more remote agents the license allows.
+// Always returns -1 if the license allows any number of remote agents.
+func (l *License) RemoteAgentsAvailable() int {
+	if l.UnlimitedRemoteAgents() {
+		return unlimitedRemoteAgents
+	}
+	if available := l.RemoteAgentsAllowed - l.RemoteAgentsUsed; available > 0 {
+		return available
+	}
+	return 0
+}
+
+// License returns the license details of the Bamboo server
+func (i *InfoService) License() (*License, *http.Response, error) {
+	request, err := i.client.NewRequest(http.MethodGet, "server/license", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	license := License{}
+	response, err := i.client.Do(request, &license)
+	if err != nil {
+		return nil, response, err
+	}
+
+	if response.StatusCode != 200 {
+		return nil, response, &simpleError{fmt.Sprintf("Getting the license returned %s", response.Status)}
+	}
+
+	return &license, response, nil
+}
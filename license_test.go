@@ -0,0 +1,39 @@
+package bamboo_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	bamboo "github.com/sukhyun/go-bamboo"
+)
+
+func TestLicense(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/latest/server/license" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Write([]byte(`{"licenseType":"COMMERCIAL","maintenanceExpiryDate":"2027-01-31T00:00:00.000Z","remoteAgentsAllowed":25,"remoteAgentsUsed":23}`))
+	}))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	license, _, err := client.Info.License()
+	assert.NoError(t, err)
+	assert.Equal(t, "COMMERCIAL", license.LicenseType)
+	assert.False(t, license.UnlimitedRemoteAgents())
+	assert.Equal(t, 2, license.RemoteAgentsAvailable())
+}
+
+func TestLicenseUnlimitedRemoteAgents(t *testing.T) {
+	license := &bamboo.License{RemoteAgentsAllowed: -1, RemoteAgentsUsed: 300}
+	assert.True(t, license.UnlimitedRemoteAgents())
+	assert.Equal(t, -1, license.RemoteAgentsAvailable())
+
+	license = &bamboo.License{RemoteAgentsAllowed: 5, RemoteAgentsUsed: 7}
+	assert.Equal(t, 0, license.RemoteAgentsAvailable())
+}
@@ -0,0 +1,122 @@
+package bamboo
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// SMTPMailSetting sends mail through an SMTP server
+const SMTPMailSetting string = "SMTP"
+
+// JNDIMailSetting sends mail through a JNDI mail session of the application server
+const JNDIMailSetting string = "JNDI"
+
+// MailServer holds the outgoing mail server configuration
+// - EmailSetting: SMTPMailSetting or JNDIMailSetting
+// - Password:     Never returned by the server, only set when updating
+// - JNDILocation: Location of the mail session, only used by JNDIMailSetting
+type MailServer struct {
+	Name                         string `json:"name"`
+	FromAddress                  string `json:"fromAddress"`
+	SubjectPrefix                string `json:"subjectPrefix,omitempty"`
+	PrecedenceBulkHeaderExcluded bool   `json:"precedenceBulkHeaderExcluded"`
+	EmailSetting                 string `json:"emailSetting"`
+	SMTPServer                   string `json:"smtpServer,omitempty"`
+	SMTPPort                     string `json:"smtpPort,omitempty"`
+	Username                     string `json:"username,omitempty"`
+	Password                     string `json:"password,omitempty"`
+	TLSEnabled                   bool   `json:"tlsEnabled"`
+	JNDILocation                 string `json:"jndiLocation,omitempty"`
+}
+
+// IMServer holds the instant messaging (XMPP) server configuration
+// - ResourceName:     XMPP resource Bamboo connects as
+// - Password:         Never returned by the server, only set when updating
+// - SecureConnection: True to require TLS
+type IMServer struct {
+	Host             string `json:"host"`
+	Port             int    `json:"port"`
+	Username         string `json:"username,omitempty"`
+	Password         string `json:"password,omitempty"`
+	ResourceName     string `json:"resourceName,omitempty"`
+	SecureConnection bool   `json:"secureConnection"`
+	EnforceLegacySSL bool   `json:"enforceLegacySsl"`
+}
+
+// MailServer returns the outgoing mail server configuration
+func (s *ServerService) MailServer() (*MailServer, *http.Response, error) {
+	mailServer := MailServer{}
+	response, err := s.getConfiguration("admin/mailServer", &mailServer, "mail server")
+	if err != nil {
+		return nil, response, err
+	}
+	return &mailServer, response, nil
+}
+
+// UpdateMailServer replaces the outgoing mail server configuration
+func (s *ServerService) UpdateMailServer(mailServer *MailServer) (*http.Response, error) {
+	if mailServer == nil || emptyStrings(mailServer.FromAddress, mailServer.EmailSetting) {
+		return nil, &simpleError{"Mail server from address and email setting cannot be empty"}
+	}
+	return s.updateConfiguration("admin/mailServer", mailServer, "mail server")
+}
+
+// IMServer returns the instant messaging server configuration
+func (s *ServerService) IMServer() (*IMServer, *http.Response, error) {
+	imServer := IMServer{}
+	response, err := s.getConfiguration("admin/imServer", &imServer, "instant messaging server")
+	if err != nil {
+		return nil, response, err
+	}
+	return &imServer, response, nil
+}
+
+// UpdateIMServer replaces the instant messaging server configuration
+func (s *ServerService) UpdateIMServer(imServer *IMServer) (*http.Response, error) {
+	if imServer == nil || emptyStrings(imServer.Host) {
+		return nil, &simpleError{"Instant messaging server host cannot be empty"}
+	}
+	return s.updateConfiguration("admin/imServer", imServer, "instant messaging server")
+}
+
+func (s *ServerService) getConfiguration(u string, v interface{}, name string) (*http.Response, error) {
+	request, err := s.client.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check the status first, a 404 may come with an HTML page which fails to decode
+	response, err := s.client.Do(request, v)
+	if response != nil && (response.StatusCode == 204 || response.StatusCode == 404) {
+		// Nothing has been configured yet
+		return response, ErrNotFound
+	}
+
+	if err != nil {
+		return response, err
+	}
+
+	if response.StatusCode != 200 {
+		return response, &simpleError{fmt.Sprintf("Getting %s configuration returned %s", name, response.Status)}
+	}
+
+	return response, nil
+}
+
+func (s *ServerService) updateConfiguration(u string, v interface{}, name string) (*http.Response, error) {
+	request, err := s.client.NewRequest(http.MethodPut, u, v)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.client.Do(request, nil)
+	if err != nil {
+		return response, err
+	}
+
+	if response.StatusCode != 200 && response.StatusCode != 204 {
+		return response, &simpleError{fmt.Sprintf("Updating %s configuration returned %s", name, response.Status)}
+	}
+
+	return response, nil
+}
@@ -0,0 +1,80 @@
+package bamboo_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	bamboo "github.com/sukhyun/go-bamboo"
+)
+
+func TestMailServer(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(notificationServersStub))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	mailServer, _, err := client.Server.MailServer()
+	assert.NoError(t, err)
+	assert.Equal(t, bamboo.SMTPMailSetting, mailServer.EmailSetting)
+	assert.Equal(t, "smtp.example.com", mailServer.SMTPServer)
+
+	mailServer.Password = "secret"
+	_, err = client.Server.UpdateMailServer(mailServer)
+	assert.NoError(t, err)
+
+	_, err = client.Server.UpdateMailServer(&bamboo.MailServer{Name: "Bamboo"})
+	assert.Error(t, err)
+}
+
+func TestIMServerNotConfigured(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(notificationServersStub))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	_, _, err := client.Server.IMServer()
+	assert.Equal(t, bamboo.ErrNotFound, err)
+
+	_, err = client.Server.UpdateIMServer(&bamboo.IMServer{Host: "xmpp.example.com", Port: 5222})
+	assert.NoError(t, err)
+}
+
+func TestMailServerNotFoundPage(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`<html><body>Page not found</body></html>`))
+	}))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	_, _, err := client.Server.MailServer()
+	assert.Equal(t, bamboo.ErrNotFound, err)
+}
+
+func notificationServersStub(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == "/rest/api/latest/admin/mailServer":
+		w.Write([]byte(`{"name":"Bamboo","fromAddress":"bamboo@example.com","emailSetting":"SMTP","smtpServer":"smtp.example.com","smtpPort":"587","tlsEnabled":true}`))
+	case r.Method == http.MethodPut && r.URL.Path == "/rest/api/latest/admin/mailServer":
+		mailServer := bamboo.MailServer{}
+		if err := json.NewDecoder(r.Body).Decode(&mailServer); err != nil || mailServer.Password != "secret" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodGet && r.URL.Path == "/rest/api/latest/admin/imServer":
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodPut && r.URL.Path == "/rest/api/latest/admin/imServer":
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusBadRequest)
+	}
+}
@@ -1,5 +1,10 @@
 package bamboo
 
+import (
+	"net/http"
+	"strconv"
+)
+
 // WritePermission the sting the API expects for write permissions.
 // Allows a user to view and edit the configuration of the plan and its jobs, not including permissions or stages.
 const WritePermission string = "WRITE"
@@ -20,6 +25,16 @@ const ClonePermission string = "CLONE"
 // Allows a user to edit all aspects of the plan including permissions and stages.
 const AdminPermission string = "ADMINISTRATION"
 
+// ViewPermission is the name used in the Bamboo UI for ReadPermission
+const ViewPermission = ReadPermission
+
+// EditPermission is the name used in the Bamboo UI for WritePermission
+const EditPermission = WritePermission
+
+// DeployPermission is the name used in the Bamboo UI for BuildPermission on a deployment environment.
+// Allows a user to deploy releases to the environment.
+const DeployPermission = BuildPermission
+
 // CreatePermission is the string the API expects when allowing a user/group to create a resource
 const CreatePermission string = "CREATE"
 
@@ -67,3 +82,50 @@ type PermissionsOpts struct {
 	Resource string
 	Key      string
 }
+
+// ProjectPermissionsOpts returns the PermissionsOpts for the permissions on the given project itself
+func ProjectPermissionsOpts(projectKey string) PermissionsOpts {
+	return PermissionsOpts{Resource: ProjectResource, Key: projectKey}
+}
+
+// ProjectPlanPermissionsOpts returns the PermissionsOpts for the permissions the plans of the given project inherit
+func ProjectPlanPermissionsOpts(projectKey string) PermissionsOpts {
+	return PermissionsOpts{Resource: ProjectPlanResource, Key: projectKey}
+}
+
+// EnvironmentPermissionsOpts returns the PermissionsOpts for the permissions on the given deployment environment
+func EnvironmentPermissionsOpts(environmentID int) PermissionsOpts {
+	return PermissionsOpts{Resource: EnvironmentResource, Key: strconv.Itoa(environmentID)}
+}
+
+// DeploymentPermissionsOpts returns the PermissionsOpts for the permissions on the given deployment project
+func DeploymentPermissionsOpts(deploymentProjectID int) PermissionsOpts {
+	return PermissionsOpts{Resource: DeploymentResource, Key: strconv.Itoa(deploymentProjectID)}
+}
+
+// ResourcePermissions holds the users, groups and roles which were explicitly granted permissions on a resource
+type ResourcePermissions struct {
+	Users  []User
+	Groups []Group
+	Roles  []Role
+}
+
+// ListPermissions returns the user, group and role permissions for the given resource. Leave Key blank for global permissions.
+func (p *Permissions) ListPermissions(opts PermissionsOpts) (*ResourcePermissions, *http.Response, error) {
+	users, response, err := p.UserPermissionsList(opts)
+	if err != nil {
+		return nil, response, err
+	}
+
+	groups, response, err := p.GroupPermissionsList(opts)
+	if err != nil {
+		return nil, response, err
+	}
+
+	roles, response, err := p.RolePermissionsList(opts)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return &ResourcePermissions{Users: users, Groups: groups, Roles: roles}, response, nil
+}
@@ -1,6 +1,14 @@
 package bamboo_test
 
-import bamboo "github.com/sukhyun/go-bamboo"
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	bamboo "github.com/sukhyun/go-bamboo"
+)
 
 var (
 	permissionsTestCases = []bamboo.PermissionsOpts{
@@ -61,3 +69,119 @@ var (
 		[]string{bamboo.CreatePermission, bamboo.AdminPermission},
 	}
 )
+
+func TestListPermissions(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(listPermissionsStub))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	opts := bamboo.PermissionsOpts{Resource: bamboo.PlanResource, Key: "TEST"}
+	permissions, _, err := client.Permissions.ListPermissions(opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(permissions.Users) != 1 || len(permissions.Groups) != 1 || len(permissions.Roles) != 1 {
+		t.Errorf("Expected one user, group and role but got %+v", permissions)
+	}
+}
+
+func listPermissionsStub(w http.ResponseWriter, r *http.Request) {
+	var resp interface{}
+	switch strings.Split(r.URL.Path, "permissions/")[1] {
+	case "plan/TEST/users":
+		resp = map[string][]bamboo.User{"results": {{Name: "user", Permissions: []string{bamboo.ViewPermission}}}}
+	case "plan/TEST/groups":
+		resp = map[string][]bamboo.Group{"results": {{Name: "group", Permissions: []string{bamboo.EditPermission}}}}
+	case "plan/TEST/roles":
+		resp = map[string][]bamboo.Role{"results": {{Name: "LOGGED_IN", Permissions: []string{bamboo.BuildPermission}}}}
+	default:
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	bytes, err := json.Marshal(resp)
+	if err != nil {
+		panic(err)
+	}
+
+	w.Write(bytes)
+}
+
+func TestProjectPermissionsOpts(t *testing.T) {
+	var requested []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = append(requested, r.URL.Path)
+		switch r.URL.Path {
+		case "/rest/api/latest/permissions/project/TEST/groups", "/rest/api/latest/permissions/projectplan/TEST/groups":
+			w.Write([]byte(`{"results":[{"name":"developers","permissions":["READ"]}]}`))
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	for _, opts := range []bamboo.PermissionsOpts{bamboo.ProjectPermissionsOpts("TEST"), bamboo.ProjectPlanPermissionsOpts("TEST")} {
+		groups, _, err := client.Permissions.GroupPermissionsList(opts)
+		if err != nil {
+			t.Error(err)
+		}
+		if len(groups) != 1 || groups[0].Name != "developers" {
+			t.Errorf("Unexpected groups %+v for %s", groups, opts.Resource)
+		}
+	}
+
+	expected := []string{"/rest/api/latest/permissions/project/TEST/groups", "/rest/api/latest/permissions/projectplan/TEST/groups"}
+	if strings.Join(requested, ",") != strings.Join(expected, ",") {
+		t.Errorf("Requested %v, expected %v", requested, expected)
+	}
+
+	// Unknown resources are rejected before any request is made
+	_, _, err := client.Permissions.GroupPermissionsList(bamboo.PermissionsOpts{Resource: "projects", Key: "TEST"})
+	if err == nil {
+		t.Error("Expected an error for an unknown resource")
+	}
+	if len(requested) != len(expected) {
+		t.Errorf("Unknown resource was requested: %v", requested[len(expected):])
+	}
+}
+
+func TestEnvironmentPermissions(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/rest/api/latest/permissions/environment/131/groups":
+			w.Write([]byte(`{"results":[{"name":"release-managers","permissions":["READ","WRITE","BUILD"]}]}`))
+		case r.Method == http.MethodPut && r.URL.Path == "/rest/api/latest/permissions/environment/131/groups/developers":
+			w.WriteHeader(http.StatusNoContent)
+		case r.Method == http.MethodDelete && r.URL.Path == "/rest/api/latest/permissions/environment/131/groups/developers":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	opts := bamboo.EnvironmentPermissionsOpts(131)
+	groups, _, err := client.Permissions.GroupPermissionsList(opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(groups) != 1 || groups[0].Permissions[2] != bamboo.DeployPermission {
+		t.Errorf("Unexpected groups %+v", groups)
+	}
+
+	if _, err := client.Permissions.SetGroupPermissions("developers", []string{bamboo.ViewPermission}, opts); err != nil {
+		t.Error(err)
+	}
+	if _, err := client.Permissions.RemoveGroupPermissions("developers", []string{bamboo.DeployPermission}, opts); err != nil {
+		t.Error(err)
+	}
+}
@@ -2,8 +2,11 @@ package bamboo
 
 import (
 	"fmt"
+	"io/ioutil"
 	"net/http"
+	"net/url"
 	"strconv"
+	"strings"
 )
 
 // PlanService handles communication with the plan related methods
@@ -11,8 +14,46 @@ type PlanService service
 
 // PlanCreateBranchOptions specifies the optional parameters
 // for the CreatePlanBranch method
+// - VCSBranch:      The VCS branch the plan branch builds, defaults to the branch name
+// - Enabled:        Enable the plan branch once created
+// - CleanupEnabled: Let Bamboo automatically remove the plan branch once it's inactive
 type PlanCreateBranchOptions struct {
-	VCSBranch string
+	VCSBranch      string
+	Enabled        bool
+	CleanupEnabled bool
+}
+
+// ChainPlanType is the type of a top level build plan
+const ChainPlanType string = "chain"
+
+// PlanListOptions specifies the optional filters for the ListPlans method.
+// Favourite and Type are applied by the server, the plan resource has no parameters for the other filters.
+// - Favourite:        Only return plans marked as favourite by the user
+// - Type:             Only return plans of the given type, e.g. ChainPlanType
+// - EnabledOnly:      Only return enabled plans, applied client side to every page
+// - ProjectKeyPrefix: Only return plans whose key starts with the given prefix, applied client side to every page
+type PlanListOptions struct {
+	Favourite        bool
+	Type             string
+	EnabledOnly      bool
+	ProjectKeyPrefix string
+}
+
+func (o *PlanListOptions) setQuery(values url.Values) {
+	if o.Favourite {
+		values.Set("favourite", "true")
+	}
+	if o.Type != "" {
+		values.Set("type", o.Type)
+	}
+}
+
+// matches reports whether the plan satisfies the filters Bamboo can't apply server side
+func (o *PlanListOptions) matches(plan *Plan) bool {
+	if o.EnabledOnly && !plan.Enabled {
+		return false
+	}
+	return strings.HasPrefix(plan.Key, o.ProjectKeyPrefix)
 }
 
 // PlanResponse encapsultes a response from the plan service
@@ -44,47 +85,64 @@ type PlanKey struct {
 	Key string `json:"key,omitempty"`
 }
 
+// YAMLSpecsFormat requests Bamboo Specs as YAML
+const YAMLSpecsFormat string = "YAML"
+
+// JSONSpecsFormat requests Bamboo Specs as JSON
+const JSONSpecsFormat string = "JSON"
+
 // SpecResponse is the information of specification
 type SpecResponse struct {
 	Spec *SpecDetail `json:"spec"`
 }
 
+// SpecDetail holds the Bamboo Specs code of a plan
 type SpecDetail struct {
 	ProjectKey string `json:"projectKey,omitempty"`
-	BuildKey string `json:"buildKey,omitempty"`
-	Code string `json:"code,omitempty`
+	BuildKey   string `json:"buildKey,omitempty"`
+	Code       string `json:"code,omitempty"`
 }
 
 // CreatePlanBranch will create a plan branch with the given branch name for the specified build
-func (p *PlanService) CreatePlanBranch(planKey, branchName string, options *PlanCreateBranchOptions) (bool, *http.Response, error) {
+// and return the created branch
+func (p *PlanService) CreatePlanBranch(planKey, branchName string, options *PlanCreateBranchOptions) (*Branch, *http.Response, error) {
 	var u string
 	if !emptyStrings(planKey, branchName) {
 		u = fmt.Sprintf("plan/%s/branch/%s.json", planKey, branchName)
 	} else {
-		return false, nil, &simpleError{"Project key and/or branch name cannot be empty"}
+		return nil, nil, &simpleError{"Project key and/or branch name cannot be empty"}
 	}
 
 	request, err := p.client.NewRequest(http.MethodPut, u, nil)
 	if err != nil {
-		return false, nil, err
+		return nil, nil, err
 	}
 
-	if options != nil && options.VCSBranch != "" {
+	if options != nil {
 		values := request.URL.Query()
-		values.Add("vcsBranch", options.VCSBranch)
+		if options.VCSBranch != "" {
+			values.Add("vcsBranch", options.VCSBranch)
+		}
+		if options.Enabled {
+			values.Add("enabled", "true")
+		}
+		if options.CleanupEnabled {
+			values.Add("cleanupEnabled", "true")
+		}
 		request.URL.RawQuery = values.Encode()
 	}
 
-	response, err := p.client.Do(request, nil)
+	branch := Branch{}
+	response, err := p.client.Do(request, &branch)
 	if err != nil {
-		return false, response, err
+		return nil, response, err
 	}
 
 	if !(response.StatusCode == 200) {
-		return false, response, &simpleError{fmt.Sprintf("Create returned %d", response.StatusCode)}
+		return nil, response, &simpleError{fmt.Sprintf("Create returned %d", response.StatusCode)}
 	}
 
-	return true, response, nil
+	return &branch, response, nil
 }
 
 // NumberOfPlans returns the number of plans on the Bamboo server
@@ -112,39 +170,59 @@ func (p *PlanService) NumberOfPlans() (int, *http.Response, error) {
 	return planResp.Plans.Size, response, nil
 }
 
-// ListPlans gets information on all plans
-func (p *PlanService) ListPlans() ([]*Plan, *http.Response, error) {
-	// Get number of plans to set max-results
-	numPlans, resp, err := p.NumberOfPlans()
-	if err != nil {
-		return nil, resp, err
+// ListPlans gets information on all plans matching the given options. A nil options
+// returns every plan. Plans are requested one page at a time.
+func (p *PlanService) ListPlans(options *PlanListOptions) ([]*Plan, *http.Response, error) {
+	plans := []*Plan{}
+
+	var response *http.Response
+	for start := 0; ; {
+		request, err := p.client.NewRequest(http.MethodGet, "plan.json", nil)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		q := request.URL.Query()
+		q.Set("start-index", strconv.Itoa(start))
+		q.Set("max-results", strconv.Itoa(defaultPageSize))
+		if options != nil {
+			options.setQuery(q)
+		}
+		request.URL.RawQuery = q.Encode()
+
+		planResp := PlanResponse{}
+		response, err = p.client.Do(request, &planResp)
+		if err != nil {
+			return nil, response, err
+		}
+
+		if response.StatusCode != 200 {
+			return nil, response, &simpleError{fmt.Sprintf("Getting plan information returned %s", response.Status)}
+		}
+
+		if planResp.Plans == nil {
+			break
+		}
+
+		page := planResp.Plans.PlanList
+		for _, plan := range page {
+			if options == nil || options.matches(plan) {
+				plans = append(plans, plan)
+			}
+		}
+
+		start += len(page)
+		if len(page) == 0 || !planResp.Plans.hasMore(start) {
+			break
+		}
 	}
 
-	request, err := p.client.NewRequest(http.MethodGet, "plan.json", nil)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	q := request.URL.Query()
-	q.Add("max-results", strconv.Itoa(numPlans))
-	request.URL.RawQuery = q.Encode()
-
-	planResp := PlanResponse{}
-	response, err := p.client.Do(request, &planResp)
-	if err != nil {
-		return nil, response, err
-	}
-
-	if response.StatusCode != 200 {
-		return nil, response, &simpleError{fmt.Sprintf("Getting plan information returned %s", response.Status)}
-	}
-
-	return planResp.Plans.PlanList, response, nil
+	return plans, response, nil
 }
 
 // ListPlanKeys get all the plan keys for all build plans on Bamboo
 func (p *PlanService) ListPlanKeys() ([]string, *http.Response, error) {
-	plans, response, err := p.ListPlans()
+	plans, response, err := p.ListPlans(nil)
 	if err != nil {
 		return nil, response, err
 	}
@@ -158,7 +236,7 @@ func (p *PlanService) ListPlanKeys() ([]string, *http.Response, error) {
 
 // ListPlanNames returns a list of ShortNames of all plans
 func (p *PlanService) ListPlanNames() ([]string, *http.Response, error) {
-	plans, response, err := p.ListPlans()
+	plans, response, err := p.ListPlans(nil)
 	if err != nil {
 		return nil, response, err
 	}
@@ -172,7 +250,7 @@ func (p *PlanService) ListPlanNames() ([]string, *http.Response, error) {
 
 // PlanNameMap returns a map[string]string where the PlanKey is the key and the ShortName is the value
 func (p *PlanService) PlanNameMap() (map[string]string, *http.Response, error) {
-	plans, response, err := p.ListPlans()
+	plans, response, err := p.ListPlans(nil)
 	if err != nil {
 		return nil, response, err
 	}
@@ -185,6 +263,64 @@ func (p *PlanService) PlanNameMap() (map[string]string, *http.Response, error) {
 	return planMap, response, nil
 }
 
+// MovePlan moves a plan to the project with the given key while keeping its build history.
+// Returns a Plan struct of the moved plan.
+func (p *PlanService) MovePlan(planKey, targetProjectKey string) (*Plan, *http.Response, error) {
+	var u string
+	if !emptyStrings(planKey, targetProjectKey) {
+		u = fmt.Sprintf("plan/%s/move.json", planKey)
+	} else {
+		return nil, nil, &simpleError{"Plan key and/or target project key cannot be empty"}
+	}
+
+	request, err := p.client.NewRequest(http.MethodPost, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	values := request.URL.Query()
+	values.Add("targetProjectKey", targetProjectKey)
+	request.URL.RawQuery = values.Encode()
+
+	movedPlan := Plan{}
+	response, err := p.client.Do(request, &movedPlan)
+	if err != nil {
+		return nil, response, err
+	}
+
+	if response.StatusCode != 200 {
+		return nil, response, &simpleError{fmt.Sprintf("Moving %s to %s returned %s", planKey, targetProjectKey, response.Status)}
+	}
+
+	return &movedPlan, response, nil
+}
+
+// DeletePlan deletes a plan or plan branch along with its build results
+func (p *PlanService) DeletePlan(planKey string) (*http.Response, error) {
+	var u string
+	if !emptyStrings(planKey) {
+		u = fmt.Sprintf("plan/%s", planKey)
+	} else {
+		return nil, &simpleError{"Plan key cannot be empty"}
+	}
+
+	request, err := p.client.NewRequest(http.MethodDelete, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := p.client.Do(request, nil)
+	if err != nil {
+		return response, err
+	}
+
+	if response.StatusCode != 200 && response.StatusCode != 204 {
+		return response, &simpleError{fmt.Sprintf("Deleting %s returned %s", planKey, response.Status)}
+	}
+
+	return response, nil
+}
+
 // DisablePlan will disable a plan or plan branch
 func (p *PlanService) DisablePlan(planKey string) (*http.Response, error) {
 	u := fmt.Sprintf("plan/%s/enable", planKey)
@@ -200,28 +336,170 @@ func (p *PlanService) DisablePlan(planKey string) (*http.Response, error) {
 	return response, nil
 }
 
-// GetSpecs gets informations on plan's spec
-func (p *PlanService) GetSpecs(key string) (string, *http.Response, error) {
-	requestValue := fmt.Sprintf("plan/%s/specs?format=YAML", key)
-	request, err := p.client.NewRequest(http.MethodGet, requestValue, nil)
+// GetSpecs gets the Bamboo Specs of a plan in the given format. An empty format defaults to YAMLSpecsFormat.
+func (p *PlanService) GetSpecs(key, format string) (*SpecDetail, *http.Response, error) {
+	if emptyStrings(key) {
+		return nil, nil, &simpleError{"Plan key cannot be empty"}
+	}
+	if format == "" {
+		format = YAMLSpecsFormat
+	}
+
+	request, err := p.client.NewRequest(http.MethodGet, fmt.Sprintf("plan/%s/specs", key), nil)
 	if err != nil {
-		return "", nil, err
+		return nil, nil, err
 	}
-  
-	// Restrict results to one for speed
+
 	values := request.URL.Query()
-	values.Add("max-results", "1")
+	values.Set("format", format)
 	request.URL.RawQuery = values.Encode()
-  
+
 	specResp := SpecResponse{}
 	response, err := p.client.Do(request, &specResp)
 	if err != nil {
-		return "", response, err
+		return nil, response, err
+	}
+
+	if response.StatusCode != 200 {
+		return nil, response, &simpleError{fmt.Sprintf("Getting the spec of plans returned %s", response.Status)}
+	}
+
+	if specResp.Spec == nil {
+		return nil, response, &simpleError{fmt.Sprintf("No specs were returned for %s", key)}
+	}
+
+	return specResp.Spec, response, nil
+}
+
+// PutSpecs updates the configuration of a plan from the given Bamboo Specs YAML
+func (p *PlanService) PutSpecs(key, yaml string) (*http.Response, error) {
+	var u string
+	if !emptyStrings(key, yaml) {
+		u = fmt.Sprintf("plan/%s/specs", key)
+	} else {
+		return nil, &simpleError{"Plan key and/or specs cannot be empty"}
+	}
+
+	request, err := p.client.NewRequest(http.MethodPut, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	request.Body = ioutil.NopCloser(strings.NewReader(yaml))
+	request.ContentLength = int64(len(yaml))
+	request.Header.Set("Content-Type", "application/yaml")
+
+	response, err := p.client.Do(request, nil)
+	if err != nil {
+		return response, err
+	}
+
+	if response.StatusCode != 200 && response.StatusCode != 204 {
+		return response, &simpleError{fmt.Sprintf("Updating the spec of %s returned %s", key, response.Status)}
+	}
+
+	return response, nil
+}
+
+// PlanDependencies holds the dependency configuration of a plan.
+// - ParentPlans: Plans which trigger the plan after a successful build
+// - ChildPlans:  Plans which are triggered by the plan after a successful build
+type PlanDependencies struct {
+	PlanKey     *PlanKey `json:"planKey,omitempty"`
+	ParentPlans []*Plan  `json:"parentPlans"`
+	ChildPlans  []*Plan  `json:"childPlans"`
+}
+
+// PlanDependencies returns the parent and child plans of the given plan
+func (p *PlanService) PlanDependencies(planKey string) (*PlanDependencies, *http.Response, error) {
+	var u string
+	if !emptyStrings(planKey) {
+		u = fmt.Sprintf("plan/%s/dependencies.json", planKey)
+	} else {
+		return nil, nil, &simpleError{"Plan key cannot be empty"}
+	}
+
+	request, err := p.client.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	dependencies := PlanDependencies{}
+	response, err := p.client.Do(request, &dependencies)
+	if err != nil {
+		return nil, response, err
+	}
+
+	if response.StatusCode != 200 {
+		return nil, response, &simpleError{fmt.Sprintf("Getting dependencies for %s returned %s", planKey, response.Status)}
+	}
+
+	return &dependencies, response, nil
+}
+
+// PlanDependencyGraph returns a map of plan keys to the keys of the child plans they trigger
+// for each of the given plans
+func (p *PlanService) PlanDependencyGraph(planKeys []string) (map[string][]string, *http.Response, error) {
+	graph := make(map[string][]string, len(planKeys))
+
+	var response *http.Response
+	for _, key := range planKeys {
+		dependencies, resp, err := p.PlanDependencies(key)
+		if err != nil {
+			return nil, resp, err
+		}
+		response = resp
+
+		children := make([]string, len(dependencies.ChildPlans))
+		for i, child := range dependencies.ChildPlans {
+			children[i] = child.Key
+		}
+		graph[key] = children
+	}
+	return graph, response, nil
+}
+
+// PlanRepository is a repository attached to a plan
+// - Linked:        True if the repository is a linked (shared) repository, false if it is local to the plan
+// - DefaultBranch: The branch the plan builds from the repository by default
+type PlanRepository struct {
+	ID            int    `json:"id"`
+	Name          string `json:"name"`
+	Type          string `json:"type,omitempty"`
+	URL           string `json:"url,omitempty"`
+	Linked        bool   `json:"linked"`
+	DefaultBranch string `json:"defaultBranch,omitempty"`
+}
+
+// PlanRepositoryResult holds the repositories returned for a plan
+type PlanRepositoryResult struct {
+	*Index
+	Repositories []*PlanRepository `json:"results"`
+}
+
+// ListPlanRepositories returns the repositories attached to the given plan
+func (p *PlanService) ListPlanRepositories(planKey string) ([]*PlanRepository, *http.Response, error) {
+	var u string
+	if !emptyStrings(planKey) {
+		u = fmt.Sprintf("plan/%s/repository", planKey)
+	} else {
+		return nil, nil, &simpleError{"Plan key cannot be empty"}
+	}
+
+	request, err := p.client.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
 	}
-  
+
+	repositories := PlanRepositoryResult{}
+	response, err := p.client.Do(request, &repositories)
+	if err != nil {
+		return nil, response, err
+	}
+
 	if response.StatusCode != 200 {
-		return "", response, &simpleError{fmt.Sprintf("Getting the spec of plans returned %s", response.Status)}
+		return nil, response, &simpleError{fmt.Sprintf("Listing repositories for %s returned %s", planKey, response.Status)}
 	}
-  
-	return specResp.Spec.Code, response, nil
+
+	return repositories.Repositories, response, nil
 }
@@ -1,10 +1,14 @@
 package bamboo_test
 
 import (
+	"encoding/json"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
 	"testing"
-	"github.com/stretchr/testify/assert"
 
 	bamboo "github.com/sukhyun/go-bamboo"
 )
@@ -13,7 +17,7 @@ func TestListPlans(t *testing.T) {
 	ts := httptest.NewServer(http.HandlerFunc(unauthorizedStub))
 	defer ts.Close()
 
-	client := bamboo.NewSimpleClient(nil, "", "")
+	client := bamboo.NewSimpleClient(nil, "", "", "")
 	client.SetURL(ts.URL)
 
 	_, response, err := client.Plans.ListPlanKeys()
@@ -26,7 +30,7 @@ func TestListPlanNames(t *testing.T) {
 	ts := httptest.NewServer(http.HandlerFunc(unauthorizedStub))
 	defer ts.Close()
 
-	client := bamboo.NewSimpleClient(nil, "", "")
+	client := bamboo.NewSimpleClient(nil, "", "", "")
 	client.SetURL(ts.URL)
 
 	_, response, err := client.Plans.ListPlanNames()
@@ -34,3 +38,259 @@ func TestListPlanNames(t *testing.T) {
 	assert.NotNil(t, response)
 	assert.Equal(t, http.StatusUnauthorized, response.StatusCode)
 }
+
+func TestPlanDependencies(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(planDependenciesStub))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	dependencies, response, err := client.Plans.PlanDependencies("CORE-TEST")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	assert.Equal(t, 1, len(dependencies.ParentPlans))
+	assert.Equal(t, "CORE-CHILD", dependencies.ChildPlans[0].Key)
+
+	graph, _, err := client.Plans.PlanDependencyGraph([]string{"CORE-TEST"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"CORE-CHILD"}, graph["CORE-TEST"])
+}
+
+func planDependenciesStub(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/rest/api/latest/plan/CORE-TEST/dependencies.json" {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	resp := bamboo.PlanDependencies{
+		ParentPlans: []*bamboo.Plan{&bamboo.Plan{Key: "CORE-PARENT"}},
+		ChildPlans:  []*bamboo.Plan{&bamboo.Plan{Key: "CORE-CHILD"}},
+	}
+
+	bytes, err := json.Marshal(resp)
+	if err != nil {
+		panic(err)
+	}
+
+	w.Write(bytes)
+}
+
+func TestListPlanRepositories(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(listPlanRepositoriesStub))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	repositories, response, err := client.Plans.ListPlanRepositories("CORE-TEST")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	assert.Equal(t, 2, len(repositories))
+	assert.True(t, repositories[0].Linked)
+	assert.Equal(t, "master", repositories[1].DefaultBranch)
+}
+
+func listPlanRepositoriesStub(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/rest/api/latest/plan/CORE-TEST/repository" {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	resp := bamboo.PlanRepositoryResult{
+		Repositories: []*bamboo.PlanRepository{
+			&bamboo.PlanRepository{ID: 1, Name: "shared", Linked: true},
+			&bamboo.PlanRepository{ID: 2, Name: "local", DefaultBranch: "master"},
+		},
+	}
+
+	bytes, err := json.Marshal(resp)
+	if err != nil {
+		panic(err)
+	}
+
+	w.Write(bytes)
+}
+
+const testSpecs = "version: 2\nplan:\n  project-key: CORE\n  key: TEST\n"
+
+func TestPutSpecs(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(putSpecsStub))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	response, err := client.Plans.PutSpecs("CORE-TEST", testSpecs)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, response.StatusCode)
+
+	_, err = client.Plans.PutSpecs("CORE-TEST", "")
+	assert.Error(t, err)
+}
+
+func putSpecsStub(w http.ResponseWriter, r *http.Request) {
+	body, _ := ioutil.ReadAll(r.Body)
+	if r.Method != http.MethodPut || r.URL.Path != "/rest/api/latest/plan/CORE-TEST/specs" ||
+		r.Header.Get("Content-Type") != "application/yaml" || string(body) != testSpecs {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func TestMovePlan(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(movePlanStub))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	plan, response, err := client.Plans.MovePlan("CORE-TEST", "OTHER")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	assert.Equal(t, "OTHER-TEST", plan.Key)
+}
+
+func movePlanStub(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost || r.URL.Path != "/rest/api/latest/plan/CORE-TEST/move.json" {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	bytes, err := json.Marshal(bamboo.Plan{Key: r.URL.Query().Get("targetProjectKey") + "-TEST"})
+	if err != nil {
+		panic(err)
+	}
+
+	w.Write(bytes)
+}
+
+func TestListPlansWithOptions(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(listPlansStub))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	plans, _, err := client.Plans.ListPlans(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(plans))
+
+	options := &bamboo.PlanListOptions{Favourite: true, EnabledOnly: true, ProjectKeyPrefix: "CORE-"}
+	plans, _, err = client.Plans.ListPlans(options)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(plans))
+	assert.Equal(t, "CORE-TEST", plans[0].Key)
+
+	plans, _, err = client.Plans.ListPlans(&bamboo.PlanListOptions{Type: "job"})
+	assert.NoError(t, err)
+	assert.Empty(t, plans)
+}
+
+func listPlansStub(w http.ResponseWriter, r *http.Request) {
+	planList := []*bamboo.Plan{
+		&bamboo.Plan{Key: "CORE-TEST", Enabled: true, Type: bamboo.ChainPlanType},
+		&bamboo.Plan{Key: "CORE-OLD", Enabled: false, Type: bamboo.ChainPlanType},
+		&bamboo.Plan{Key: "OTHER-TEST", Enabled: true, Type: bamboo.ChainPlanType},
+	}
+	if r.URL.Query().Get("favourite") == "true" {
+		planList = planList[:2]
+	}
+	if planType := r.URL.Query().Get("type"); planType != "" && planType != bamboo.ChainPlanType {
+		planList = nil
+	}
+
+	resp := bamboo.PlanResponse{Plans: pagePlans(r, planList)}
+
+	bytes, err := json.Marshal(resp)
+	if err != nil {
+		panic(err)
+	}
+
+	w.Write(bytes)
+}
+
+func TestListPlansPagination(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(manyPlansStub))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	plans, _, err := client.Plans.ListPlans(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 250, len(plans))
+	assert.Equal(t, "CORE-P249", plans[249].Key)
+}
+
+func manyPlansStub(w http.ResponseWriter, r *http.Request) {
+	planList := make([]*bamboo.Plan, 250)
+	for i := range planList {
+		planList[i] = &bamboo.Plan{Key: fmt.Sprintf("CORE-P%d", i)}
+	}
+
+	bytes, err := json.Marshal(bamboo.PlanResponse{Plans: pagePlans(r, planList)})
+	if err != nil {
+		panic(err)
+	}
+
+	w.Write(bytes)
+}
+
+// pagePlans returns the page of plans requested by the start-index and max-results parameters
+func pagePlans(r *http.Request, planList []*bamboo.Plan) *bamboo.Plans {
+	start, _ := strconv.Atoi(r.URL.Query().Get("start-index"))
+	max, err := strconv.Atoi(r.URL.Query().Get("max-results"))
+	if err != nil {
+		max = 25
+	}
+
+	end := start + max
+	if end > len(planList) {
+		end = len(planList)
+	}
+
+	return &bamboo.Plans{
+		CollectionMetadata: &bamboo.CollectionMetadata{Size: len(planList), StartIndex: start, MaxResult: max},
+		PlanList:           planList[start:end],
+	}
+}
+
+func TestGetSpecs(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(getSpecsStub))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	spec, _, err := client.Plans.GetSpecs("CORE-TEST", "")
+	assert.NoError(t, err)
+	assert.Equal(t, "CORE", spec.ProjectKey)
+	assert.Equal(t, "TEST", spec.BuildKey)
+	assert.Equal(t, bamboo.YAMLSpecsFormat, spec.Code)
+
+	spec, _, err = client.Plans.GetSpecs("CORE-TEST", bamboo.JSONSpecsFormat)
+	assert.NoError(t, err)
+	assert.Equal(t, bamboo.JSONSpecsFormat, spec.Code)
+}
+
+func getSpecsStub(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/rest/api/latest/plan/CORE-TEST/specs" {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	// Echo the requested format back as the code so the test can check it was applied
+	resp := bamboo.SpecResponse{
+		Spec: &bamboo.SpecDetail{ProjectKey: "CORE", BuildKey: "TEST", Code: r.URL.Query().Get("format")},
+	}
+
+	bytes, err := json.Marshal(resp)
+	if err != nil {
+		panic(err)
+	}
+
+	w.Write(bytes)
+}
@@ -0,0 +1,205 @@
+package bamboo
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// pluginsBase is the Universal Plugin Manager REST API, relative to the REST API base URL
+const pluginsBase = "../../plugins/1.0/"
+
+// PluginService handles installing and managing apps through the Universal Plugin Manager
+type PluginService service
+
+// Plugin represents a single installed app
+// - Key:           The app key, e.g. "com.atlassian.bamboo.plugins.bamboo-docker-plugin"
+// - UserInstalled: True if the app was installed by an administrator rather than bundled with Bamboo
+type Plugin struct {
+	Key           string `json:"key"`
+	Name          string `json:"name"`
+	Version       string `json:"version"`
+	Enabled       bool   `json:"enabled"`
+	UserInstalled bool   `json:"userInstalled"`
+	Description   string `json:"description,omitempty"`
+}
+
+type pluginsResponse struct {
+	Plugins []*Plugin `json:"plugins"`
+}
+
+// PluginInstallTask is the asynchronous task installing an app
+// - Links: Links of the task, "self" is polled to follow the installation
+type PluginInstallTask struct {
+	Type   string            `json:"type"`
+	Status *PluginTaskStatus `json:"status"`
+	Links  map[string]string `json:"links,omitempty"`
+}
+
+// PluginTaskStatus is the progress of a plugin manager task
+type PluginTaskStatus struct {
+	Done bool `json:"done"`
+}
+
+type pluginInstallRequest struct {
+	PluginURI string `json:"pluginUri"`
+}
+
+func pluginURL(key string) string {
+	return fmt.Sprintf("%s%s-key", pluginsBase, url.PathEscape(key))
+}
+
+// ListPlugins returns the apps installed on the server
+func (p *PluginService) ListPlugins() ([]*Plugin, *http.Response, error) {
+	request, err := p.client.NewRequest(http.MethodGet, pluginsBase, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	plugins := pluginsResponse{}
+	response, err := p.client.Do(request, &plugins)
+	if err != nil {
+		return nil, response, err
+	}
+
+	if response.StatusCode != 200 {
+		return nil, response, &simpleError{fmt.Sprintf("Listing plugins returned %s", response.Status)}
+	}
+
+	if plugins.Plugins == nil {
+		return []*Plugin{}, response, nil
+	}
+	return plugins.Plugins, response, nil
+}
+
+// GetPlugin returns the installed app with the given key
+func (p *PluginService) GetPlugin(key string) (*Plugin, *http.Response, error) {
+	if emptyStrings(key) {
+		return nil, nil, &simpleError{"Plugin key cannot be empty"}
+	}
+
+	request, err := p.client.NewRequest(http.MethodGet, pluginURL(key), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	plugin := Plugin{}
+	response, err := p.client.Do(request, &plugin)
+	if err != nil {
+		return nil, response, err
+	}
+
+	if response.StatusCode == 404 {
+		return nil, response, ErrNotFound
+	}
+
+	if response.StatusCode != 200 {
+		return nil, response, &simpleError{fmt.Sprintf("Getting plugin %s returned %s", key, response.Status)}
+	}
+
+	return &plugin, response, nil
+}
+
+// IsEnabled reports whether the installed app with the given key is enabled
+func (p *PluginService) IsEnabled(key string) (bool, *http.Response, error) {
+	plugin, response, err := p.GetPlugin(key)
+	if err != nil {
+		return false, response, err
+	}
+	return plugin.Enabled, response, nil
+}
+
+// EnablePlugin enables the installed app with the given key
+func (p *PluginService) EnablePlugin(key string) (*http.Response, error) {
+	return p.setEnabled(key, true)
+}
+
+// DisablePlugin disables the installed app with the given key
+func (p *PluginService) DisablePlugin(key string) (*http.Response, error) {
+	return p.setEnabled(key, false)
+}
+
+func (p *PluginService) setEnabled(key string, enabled bool) (*http.Response, error) {
+	// The plugin manager expects the whole app back with the changed state
+	plugin, response, err := p.GetPlugin(key)
+	if err != nil {
+		return response, err
+	}
+	plugin.Enabled = enabled
+
+	request, err := p.client.NewRequest(http.MethodPut, pluginURL(key), plugin)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Content-Type", "application/vnd.atl.plugins.plugin+json")
+
+	response, err = p.client.Do(request, nil)
+	if err != nil {
+		return response, err
+	}
+
+	if response.StatusCode != 200 {
+		return response, &simpleError{fmt.Sprintf("Changing the state of plugin %s returned %s", key, response.Status)}
+	}
+
+	return response, nil
+}
+
+// InstallPlugin installs the app downloaded from the given URI, e.g. a Marketplace download link.
+// The installation continues in the background, poll the returned task to follow it.
+func (p *PluginService) InstallPlugin(pluginURI string) (*PluginInstallTask, *http.Response, error) {
+	if emptyStrings(pluginURI) {
+		return nil, nil, &simpleError{"Plugin URI cannot be empty"}
+	}
+
+	token, response, err := p.installToken()
+	if err != nil {
+		return nil, response, err
+	}
+
+	request, err := p.client.NewRequest(http.MethodPost, pluginsBase, &pluginInstallRequest{PluginURI: pluginURI})
+	if err != nil {
+		return nil, nil, err
+	}
+	request.Header.Set("Content-Type", "application/vnd.atl.plugins.install.uri+json")
+
+	values := request.URL.Query()
+	values.Set("token", token)
+	request.URL.RawQuery = values.Encode()
+
+	task := PluginInstallTask{}
+	response, err = p.client.Do(request, &task)
+	if err != nil {
+		return nil, response, err
+	}
+
+	if response.StatusCode != 200 && response.StatusCode != 202 {
+		return nil, response, &simpleError{fmt.Sprintf("Installing plugin from %s returned %s", pluginURI, response.Status)}
+	}
+
+	return &task, response, nil
+}
+
+// installToken returns the single use token the plugin manager requires for installing apps
+func (p *PluginService) installToken() (string, *http.Response, error) {
+	request, err := p.client.NewRequest(http.MethodHead, pluginsBase, nil)
+	if err != nil {
+		return "", nil, err
+	}
+
+	values := request.URL.Query()
+	values.Set("os_authType", "basic")
+	request.URL.RawQuery = values.Encode()
+
+	response, err := p.client.Do(request, nil)
+	if err != nil {
+		return "", response, err
+	}
+
+	token := response.Header.Get("upm-token")
+	if response.StatusCode != 200 || token == "" {
+		return "", response, &simpleError{fmt.Sprintf("Requesting a plugin install token returned %s", response.Status)}
+	}
+
+	return token, response, nil
+}
@@ -0,0 +1,91 @@
+package bamboo_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	bamboo "github.com/sukhyun/go-bamboo"
+)
+
+const dockerPluginKey = "com.atlassian.bamboo.plugins.bamboo-docker-plugin"
+
+func TestPlugins(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(pluginsStub))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	plugins, _, err := client.Plugins.ListPlugins()
+	assert.NoError(t, err)
+	if assert.Len(t, plugins, 1) {
+		assert.Equal(t, dockerPluginKey, plugins[0].Key)
+	}
+
+	enabled, _, err := client.Plugins.IsEnabled(dockerPluginKey)
+	assert.NoError(t, err)
+	assert.True(t, enabled)
+
+	_, err = client.Plugins.DisablePlugin(dockerPluginKey)
+	assert.NoError(t, err)
+
+	_, _, err = client.Plugins.GetPlugin("com.example.missing")
+	assert.Equal(t, bamboo.ErrNotFound, err)
+}
+
+func TestInstallPlugin(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(pluginsStub))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	task, response, err := client.Plugins.InstallPlugin("https://marketplace.example.com/download/plugin.jar")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusAccepted, response.StatusCode)
+	assert.False(t, task.Status.Done)
+	assert.Equal(t, "/rest/plugins/1.0/pending/1", task.Links["self"])
+
+	_, _, err = client.Plugins.InstallPlugin("")
+	assert.Error(t, err)
+}
+
+func pluginsStub(w http.ResponseWriter, r *http.Request) {
+	pluginPath := "/rest/plugins/1.0/" + dockerPluginKey + "-key"
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == "/rest/plugins/1.0/":
+		w.Write([]byte(`{"plugins":[{"key":"` + dockerPluginKey + `","name":"Docker","enabled":true}]}`))
+	case r.Method == http.MethodHead && r.URL.Path == "/rest/plugins/1.0/":
+		w.Header().Set("upm-token", "abc123")
+	case r.Method == http.MethodPost && r.URL.Path == "/rest/plugins/1.0/":
+		install := struct {
+			PluginURI string `json:"pluginUri"`
+		}{}
+		if r.URL.Query().Get("token") != "abc123" || r.Header.Get("Content-Type") != "application/vnd.atl.plugins.install.uri+json" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		if err := json.NewDecoder(r.Body).Decode(&install); err != nil || install.PluginURI == "" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusAccepted)
+		w.Write([]byte(`{"type":"INSTALL","status":{"done":false},"links":{"self":"/rest/plugins/1.0/pending/1"}}`))
+	case r.Method == http.MethodGet && r.URL.Path == pluginPath:
+		w.Write([]byte(`{"key":"` + dockerPluginKey + `","name":"Docker","enabled":true}`))
+	case r.Method == http.MethodPut && r.URL.Path == pluginPath:
+		plugin := bamboo.Plugin{}
+		if err := json.NewDecoder(r.Body).Decode(&plugin); err != nil || plugin.Enabled || r.Header.Get("Content-Type") != "application/vnd.atl.plugins.plugin+json" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Write([]byte(`{}`))
+	case r.Method == http.MethodGet:
+		w.WriteHeader(http.StatusNotFound)
+	default:
+		w.WriteHeader(http.StatusBadRequest)
+	}
+}
@@ -1,6 +1,7 @@
 package bamboo
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
@@ -44,13 +45,13 @@ type ProjectPlansInformation struct {
 
 // ProjectRepos is the information for project's repositories
 type ProjectRepos struct {
-	Id int `json:"id"`
-	Name string `json:"name"`
-	Url string `json:"url"`
+	Id       int    `json:"id"`
+	Name     string `json:"name"`
+	Url      string `json:"url"`
 	Location string `json:"location"`
-	Icon string `json:"string"`
-	Type string `json:"type"`
-	IsAdmin bool `json:"isAdmin"`
+	Icon     string `json:"string"`
+	Type     string `json:"type"`
+	IsAdmin  bool   `json:"isAdmin"`
 }
 
 type ProjectRepositoryResult struct {
@@ -86,8 +87,34 @@ func (p *ProjectService) ProjectInfo(projectKey string) (*ProjectInformation, *h
 	return &projectInfo, response, nil
 }
 
-// ProjectPlans returns a list of plans for a given project
-func (p *ProjectService) ProjectPlans(projectKey string) ([]*Plan, *http.Response, error) {
+// ProjectPlans returns the plans of the given project. A nil page returns every plan of
+// the project, requesting them one page at a time.
+func (p *ProjectService) ProjectPlans(projectKey string, page *Pagination) ([]*Plan, *http.Response, error) {
+	if page != nil {
+		plans, response, err := p.projectPlansPage(projectKey, *page)
+		if err != nil {
+			return nil, response, err
+		}
+		return plans.PlanList, response, nil
+	}
+
+	planList := []*Plan{}
+	next := Pagination{Limit: defaultPageSize}
+	for {
+		plans, response, err := p.projectPlansPage(projectKey, next)
+		if err != nil {
+			return nil, response, err
+		}
+
+		planList = append(planList, plans.PlanList...)
+		next.Start += len(plans.PlanList)
+		if len(plans.PlanList) == 0 || !plans.hasMore(next.Start) {
+			return planList, response, nil
+		}
+	}
+}
+
+func (p *ProjectService) projectPlansPage(projectKey string, page Pagination) (*Plans, *http.Response, error) {
 	var u string
 	if !emptyStrings(projectKey) {
 		u = fmt.Sprintf("project/%s.json", projectKey)
@@ -102,7 +129,7 @@ func (p *ProjectService) ProjectPlans(projectKey string) ([]*Plan, *http.Respons
 
 	values := request.URL.Query()
 	values.Set("expand", "plans")
-	values.Set("max-result", "1000")
+	page.setIndexQuery(values)
 	request.URL.RawQuery = values.Encode()
 
 	projectResponse := PlanResponse{}
@@ -116,11 +143,52 @@ func (p *ProjectService) ProjectPlans(projectKey string) ([]*Plan, *http.Respons
 			errors.New("Getting Project Plans returned: " + response.Status)
 	}
 
-	return projectResponse.Plans.PlanList, response, nil
+	if projectResponse.Plans == nil {
+		return &Plans{}, response, nil
+	}
+	return projectResponse.Plans, response, nil
+}
+
+// ProjectListOptions specifies the optional parameters
+// for the ListProjects method
+// - Page:   Only return the given page of projects. Every project is returned if nil.
+// - Expand: Elements of the projects to expand, e.g. "projects.project.plans"
+type ProjectListOptions struct {
+	Page   *Pagination
+	Expand string
+}
+
+// ListProjects lists the projects matching the given options. A nil options lists all projects.
+func (p *ProjectService) ListProjects(options *ProjectListOptions) ([]*Project, *http.Response, error) {
+	if options == nil {
+		options = &ProjectListOptions{}
+	}
+
+	if options.Page != nil {
+		projects, response, err := p.listProjectsPage(*options.Page, options.Expand)
+		if err != nil {
+			return nil, response, err
+		}
+		return projects.ProjectList, response, nil
+	}
+
+	projectList := []*Project{}
+	next := Pagination{Limit: defaultPageSize}
+	for {
+		projects, response, err := p.listProjectsPage(next, options.Expand)
+		if err != nil {
+			return nil, response, err
+		}
+
+		projectList = append(projectList, projects.ProjectList...)
+		next.Start += len(projects.ProjectList)
+		if len(projects.ProjectList) == 0 || !projects.hasMore(next.Start) {
+			return projectList, response, nil
+		}
+	}
 }
 
-// ListProjects lists all projects
-func (p *ProjectService) ListProjects() ([]*Project, *http.Response, error) {
+func (p *ProjectService) listProjectsPage(page Pagination, expand string) (*Projects, *http.Response, error) {
 	u := "project.json"
 
 	request, err := p.client.NewRequest(http.MethodGet, u, nil)
@@ -128,6 +196,13 @@ func (p *ProjectService) ListProjects() ([]*Project, *http.Response, error) {
 		return nil, nil, err
 	}
 
+	values := request.URL.Query()
+	page.setIndexQuery(values)
+	if expand != "" {
+		values.Set("expand", expand)
+	}
+	request.URL.RawQuery = values.Encode()
+
 	projectResp := ProjectResponse{}
 	response, err := p.client.Do(request, &projectResp)
 	if err != nil {
@@ -138,27 +213,410 @@ func (p *ProjectService) ListProjects() ([]*Project, *http.Response, error) {
 		return nil, response, &simpleError{fmt.Sprintf("List projects returned %s", response.Status)}
 	}
 
-	return projectResp.Projects.ProjectList, response, nil
+	if projectResp.Projects == nil {
+		return &Projects{}, response, nil
+	}
+	return projectResp.Projects, response, nil
 }
 
-func (p *ProjectService) ProjectRepositories(projectKey string) ([]*ProjectRepos, *http.Response, error) {
-	u := fmt.Sprintf("project/%s/repositories", projectKey)
-  
-	request, err := p.client.NewRequest(http.MethodGet, u, nil)
+// ProjectRepositories returns a page of the repositories shared by the plans of the given project.
+// A nil page returns the server's default first page.
+func (p *ProjectService) ProjectRepositories(projectKey string, page *Pagination) ([]*ProjectRepos, *http.Response, error) {
+	result, response, err := p.projectRepositoriesPage(projectKey, page)
+	if err != nil {
+		return nil, response, err
+	}
+	return result.Repositories, response, nil
+}
+
+// AllProjectRepositories returns every repository shared by the plans of the given project
+func (p *ProjectService) AllProjectRepositories(projectKey string) ([]*ProjectRepos, *http.Response, error) {
+	repositories := []*ProjectRepos{}
+	page := &Pagination{Limit: defaultPageSize}
+
+	for {
+		result, response, err := p.projectRepositoriesPage(projectKey, page)
+		if err != nil {
+			return nil, response, err
+		}
+
+		repositories = append(repositories, result.Repositories...)
+		if !result.hasMore(len(result.Repositories)) {
+			return repositories, response, nil
+		}
+		page.Start += len(result.Repositories)
+	}
+}
+
+func (p *ProjectService) projectRepositoriesPage(projectKey string, page *Pagination) (*ProjectRepositoryResult, *http.Response, error) {
+	if emptyStrings(projectKey) {
+		return nil, nil, &simpleError{"Project key cannot be an empty string"}
+	}
+
+	request, err := p.client.NewRequest(http.MethodGet, fmt.Sprintf("project/%s/repositories", projectKey), nil)
 	if err != nil {
 		return nil, nil, err
 	}
-  
+
+	values := request.URL.Query()
+	page.setQuery(values)
+	request.URL.RawQuery = values.Encode()
+
 	projectInfo := ProjectRepositoryResult{}
 	response, err := p.client.Do(request, &projectInfo)
 	if err != nil {
-		return nil, nil, err
+		return nil, response, err
 	}
-  
+
 	if response.StatusCode != http.StatusOK {
 		return nil, response,
-		&simpleError{fmt.Sprintf("Getting Project Information returned: %s", response.Status)}
+			&simpleError{fmt.Sprintf("Getting Project Information returned: %s", response.Status)}
+	}
+
+	return &projectInfo, response, nil
+}
+
+// CreateProject creates a new project with the given key, name and description
+func (p *ProjectService) CreateProject(key, name, description string) (*Project, *http.Response, error) {
+	if emptyStrings(key, name) {
+		return nil, nil, &simpleError{"Project key and/or name cannot be empty"}
+	}
+
+	project := &Project{Key: key, Name: name, Description: description}
+	request, err := p.client.NewRequest(http.MethodPost, "project", project)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	created := Project{}
+	response, err := p.client.Do(request, &created)
+	if err != nil {
+		return nil, response, err
+	}
+
+	if response.StatusCode != http.StatusOK && response.StatusCode != http.StatusCreated {
+		return nil, response, &simpleError{fmt.Sprintf("Creating project %s returned %s", key, response.Status)}
+	}
+
+	return &created, response, nil
+}
+
+// ProjectDeleteOptions specifies the optional parameters
+// for the DeleteProject method
+// - DeletePlans: Confirms the project's plans may be deleted along with it
+//
+// Without DeletePlans a project which still contains plans is not deleted.
+type ProjectDeleteOptions struct {
+	DeletePlans bool
+}
+
+// DeleteProject deletes the given project
+func (p *ProjectService) DeleteProject(projectKey string, options *ProjectDeleteOptions) (*http.Response, error) {
+	if emptyStrings(projectKey) {
+		return nil, &simpleError{"Project key cannot be an empty string"}
+	}
+
+	if options == nil || !options.DeletePlans {
+		info, response, err := p.ProjectInfo(projectKey)
+		if err != nil {
+			return response, err
+		}
+		if info.NumPlans != nil && info.NumPlans.Size > 0 {
+			return response, &simpleError{fmt.Sprintf("Project %s still contains %d plans", projectKey, info.NumPlans.Size)}
+		}
+	}
+
+	request, err := p.client.NewRequest(http.MethodDelete, fmt.Sprintf("project/%s", projectKey), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := p.client.Do(request, nil)
+	if err != nil {
+		return response, err
+	}
+
+	if response.StatusCode != http.StatusOK && response.StatusCode != http.StatusNoContent {
+		return response, &simpleError{fmt.Sprintf("Deleting project %s returned %s", projectKey, response.Status)}
+	}
+
+	return response, nil
+}
+
+// ProjectUpdate holds the project fields to update. Empty fields are left unchanged.
+type ProjectUpdate struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// UpdateProject updates the name and/or description of the given project
+func (p *ProjectService) UpdateProject(projectKey string, fields *ProjectUpdate) (*Project, *http.Response, error) {
+	if emptyStrings(projectKey) || fields == nil {
+		return nil, nil, &simpleError{"Project key and/or fields cannot be empty"}
+	}
+
+	request, err := p.client.NewRequest(http.MethodPut, fmt.Sprintf("project/%s", projectKey), fields)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	updated := Project{}
+	response, err := p.client.Do(request, &updated)
+	if err != nil {
+		return nil, response, err
+	}
+
+	if response.StatusCode != http.StatusOK {
+		return nil, response, &simpleError{fmt.Sprintf("Updating project %s returned %s", projectKey, response.Status)}
+	}
+
+	return &updated, response, nil
+}
+
+// AddRepository creates a repository shared by the plans of the given project and returns it
+func (p *ProjectService) AddRepository(projectKey string, repository *ProjectRepos) (*ProjectRepos, *http.Response, error) {
+	if emptyStrings(projectKey) || repository == nil || repository.Name == "" {
+		return nil, nil, &simpleError{"Project key and/or repository name cannot be empty"}
+	}
+
+	request, err := p.client.NewRequest(http.MethodPost, fmt.Sprintf("project/%s/repositories", projectKey), repository)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	created := ProjectRepos{}
+	response, err := p.client.Do(request, &created)
+	if err != nil {
+		return nil, response, err
+	}
+
+	if response.StatusCode != http.StatusOK && response.StatusCode != http.StatusCreated {
+		return nil, response, &simpleError{fmt.Sprintf("Adding repository %s to project %s returned %s", repository.Name, projectKey, response.Status)}
+	}
+
+	return &created, response, nil
+}
+
+// RemoveRepository removes the repository with the given id from the given project
+func (p *ProjectService) RemoveRepository(projectKey string, repositoryID int) (*http.Response, error) {
+	if emptyStrings(projectKey) {
+		return nil, &simpleError{"Project key cannot be an empty string"}
+	}
+
+	request, err := p.client.NewRequest(http.MethodDelete, fmt.Sprintf("project/%s/repositories/%d", projectKey, repositoryID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := p.client.Do(request, nil)
+	if err != nil {
+		return response, err
+	}
+
+	if response.StatusCode != http.StatusOK && response.StatusCode != http.StatusNoContent {
+		return response, &simpleError{fmt.Sprintf("Removing repository %d from project %s returned %s", repositoryID, projectKey, response.Status)}
+	}
+
+	return response, nil
+}
+
+// SpecsAccessRepositories returns the repositories allowed to modify the given project with Bamboo Specs
+func (p *ProjectService) SpecsAccessRepositories(projectKey string) ([]*ProjectRepos, *http.Response, error) {
+	if emptyStrings(projectKey) {
+		return nil, nil, &simpleError{"Project key cannot be an empty string"}
+	}
+
+	request, err := p.client.NewRequest(http.MethodGet, fmt.Sprintf("project/%s/specs/repositories", projectKey), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := ProjectRepositoryResult{}
+	response, err := p.client.Do(request, &result)
+	if err != nil {
+		return nil, response, err
+	}
+
+	if response.StatusCode != http.StatusOK {
+		return nil, response, &simpleError{fmt.Sprintf("Getting Specs access of project %s returned %s", projectKey, response.Status)}
+	}
+
+	return result.Repositories, response, nil
+}
+
+// GrantSpecsAccess allows the repository with the given id to modify the given project with Bamboo Specs
+func (p *ProjectService) GrantSpecsAccess(projectKey string, repositoryID int) (*http.Response, error) {
+	if emptyStrings(projectKey) {
+		return nil, &simpleError{"Project key cannot be an empty string"}
+	}
+
+	body := struct {
+		ID int `json:"id"`
+	}{repositoryID}
+	request, err := p.client.NewRequest(http.MethodPost, fmt.Sprintf("project/%s/specs/repositories", projectKey), body)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := p.client.Do(request, nil)
+	if err != nil {
+		return response, err
+	}
+
+	if response.StatusCode != http.StatusOK && response.StatusCode != http.StatusNoContent {
+		return response, &simpleError{fmt.Sprintf("Granting Specs access on project %s to repository %d returned %s", projectKey, repositoryID, response.Status)}
+	}
+
+	return response, nil
+}
+
+// RevokeSpecsAccess stops the repository with the given id from modifying the given project with Bamboo Specs
+func (p *ProjectService) RevokeSpecsAccess(projectKey string, repositoryID int) (*http.Response, error) {
+	if emptyStrings(projectKey) {
+		return nil, &simpleError{"Project key cannot be an empty string"}
+	}
+
+	request, err := p.client.NewRequest(http.MethodDelete, fmt.Sprintf("project/%s/specs/repositories/%d", projectKey, repositoryID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := p.client.Do(request, nil)
+	if err != nil {
+		return response, err
+	}
+
+	if response.StatusCode != http.StatusOK && response.StatusCode != http.StatusNoContent {
+		return response, &simpleError{fmt.Sprintf("Revoking Specs access on project %s from repository %d returned %s", projectKey, repositoryID, response.Status)}
+	}
+
+	return response, nil
+}
+
+// ProjectStatus summarizes the latest build state of the plans in a project
+// - Successful: Number of enabled plans whose latest build succeeded
+// - Failing:    Number of enabled plans whose latest build failed
+// - Disabled:   Number of disabled plans
+// - NotBuilt:   Number of enabled plans without a finished build
+// - PlanStates: Build state of the latest result of each plan, keyed by plan key
+type ProjectStatus struct {
+	ProjectKey string
+	Successful int
+	Failing    int
+	Disabled   int
+	NotBuilt   int
+	PlanStates map[string]string
+}
+
+// ProjectStatus returns the latest build state of every plan in the given project.
+// The recent results of the whole project are read in one request, plans without a result
+// among them are looked up one by one.
+func (p *ProjectService) ProjectStatus(projectKey string) (*ProjectStatus, *http.Response, error) {
+	plans, response, err := p.ProjectPlans(projectKey, nil)
+	if err != nil {
+		return nil, response, err
+	}
+
+	latest := map[string]*Result{}
+	if len(plans) > 0 {
+		latest, response, err = p.latestProjectResults(projectKey, plans)
+		if err != nil {
+			return nil, response, err
+		}
+	}
+
+	status := &ProjectStatus{ProjectKey: projectKey, PlanStates: make(map[string]string, len(plans))}
+	for _, plan := range plans {
+		result, built := latest[plan.Key]
+		if built {
+			status.PlanStates[plan.Key] = result.BuildState
+		}
+
+		switch {
+		case !plan.Enabled:
+			status.Disabled++
+		case !built:
+			status.NotBuilt++
+		case result.BuildState == SuccessfulBuildState:
+			status.Successful++
+		case result.BuildState == FailedBuildState:
+			status.Failing++
+		default:
+			status.NotBuilt++
+		}
 	}
-  
-	return projectInfo.Repositories, response, nil
- }
+	return status, response, nil
+}
+
+// latestProjectResults returns the latest result of each of the given plans of a project, keyed by plan key.
+// Plans which were never built are missing from the returned map.
+func (p *ProjectService) latestProjectResults(projectKey string, plans []*Plan) (map[string]*Result, *http.Response, error) {
+	request, err := p.client.NewRequest(http.MethodGet, fmt.Sprintf("result/%s.json", projectKey), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := Pagination{Limit: defaultPageSize}
+	if len(plans) > page.Limit {
+		page.Limit = len(plans)
+	}
+	values := request.URL.Query()
+	page.setIndexQuery(values)
+	request.URL.RawQuery = values.Encode()
+
+	results := ResultsResponse{}
+	response, err := p.client.Do(request, &results)
+	if err != nil {
+		return nil, response, err
+	}
+
+	if response.StatusCode != http.StatusOK {
+		return nil, response, &simpleError{fmt.Sprintf("Getting results of project %s returned %s", projectKey, response.Status)}
+	}
+
+	latest := map[string]*Result{}
+	if results.Results != nil {
+		for _, result := range results.Results.ResultList {
+			key := result.PlanKey()
+			if current, ok := latest[key]; !ok || result.BuildNumber > current.BuildNumber {
+				latest[key] = result
+			}
+		}
+	}
+
+	missing := map[string]bool{}
+	for _, plan := range plans {
+		if _, ok := latest[plan.Key]; !ok {
+			missing[plan.Key] = true
+		}
+	}
+
+	// Plans with many recent builds can crowd the others out of the project results. The latest
+	// results of all plans hold the rest, plans which were never built are missing from them.
+	resultService := (*ResultService)(p)
+	options := ResultListOptions{Page: &Pagination{Limit: defaultPageSize}}
+	for len(missing) > 0 {
+		results, resp, err := resultService.resultsPage(context.Background(), resultsBase+".json", &options)
+		if err != nil {
+			return nil, resp, err
+		}
+		response = resp
+
+		for _, result := range results.ResultList {
+			if key := result.PlanKey(); missing[key] {
+				latest[key] = result
+				delete(missing, key)
+			}
+		}
+
+		if results.CollectionMetadata == nil || results.MaxResult == 0 {
+			break
+		}
+		options.Page.Start = results.StartIndex + results.MaxResult
+		if !results.hasMore(options.Page.Start) {
+			break
+		}
+	}
+
+	return latest, response, nil
+}
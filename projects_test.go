@@ -5,6 +5,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/sukhyun/go-bamboo"
@@ -14,7 +16,7 @@ func TestProjectInfo(t *testing.T) {
 	ts := httptest.NewServer(http.HandlerFunc(unauthorizedStub))
 	defer ts.Close()
 
-	client := bamboo.NewSimpleClient(nil, "", "")
+	client := bamboo.NewSimpleClient(nil, "", "", "")
 	client.SetURL(ts.URL)
 
 	_, response, err := client.Projects.ProjectInfo("ABC")
@@ -27,7 +29,7 @@ func TestProjectInfo2(t *testing.T) {
 	ts := httptest.NewServer(http.HandlerFunc(projectInfoStub))
 	defer ts.Close()
 
-	client := bamboo.NewSimpleClient(nil, "", "")
+	client := bamboo.NewSimpleClient(nil, "", "", "")
 	client.SetURL(ts.URL)
 
 	result, response, err := client.Projects.ProjectInfo("ABC")
@@ -56,10 +58,10 @@ func TestProjectPlans(t *testing.T) {
 	ts := httptest.NewServer(http.HandlerFunc(unauthorizedStub))
 	defer ts.Close()
 
-	client := bamboo.NewSimpleClient(nil, "", "")
+	client := bamboo.NewSimpleClient(nil, "", "", "")
 	client.SetURL(ts.URL)
 
-	_, response, err := client.Projects.ProjectPlans("ABC")
+	_, response, err := client.Projects.ProjectPlans("ABC", nil)
 	assert.Error(t, err)
 	assert.NotNil(t, response)
 	assert.Equal(t, http.StatusUnauthorized, response.StatusCode)
@@ -69,10 +71,10 @@ func TestProjectPlans2(t *testing.T) {
 	ts := httptest.NewServer(http.HandlerFunc(projectPlansStub))
 	defer ts.Close()
 
-	client := bamboo.NewSimpleClient(nil, "", "")
+	client := bamboo.NewSimpleClient(nil, "", "", "")
 	client.SetURL(ts.URL)
 
-	result, response, err := client.Projects.ProjectPlans("ABC")
+	result, response, err := client.Projects.ProjectPlans("ABC", nil)
 	assert.NoError(t, err)
 	assert.NotNil(t, response)
 	assert.Equal(t, http.StatusOK, response.StatusCode)
@@ -96,10 +98,10 @@ func TestListProjects(t *testing.T) {
 	ts := httptest.NewServer(http.HandlerFunc(unauthorizedStub))
 	defer ts.Close()
 
-	client := bamboo.NewSimpleClient(nil, "", "")
+	client := bamboo.NewSimpleClient(nil, "", "", "")
 	client.SetURL(ts.URL)
 
-	_, response, err := client.Projects.ListProjects()
+	_, response, err := client.Projects.ListProjects(nil)
 	assert.Error(t, err)
 	assert.NotNil(t, response)
 	assert.Equal(t, http.StatusUnauthorized, response.StatusCode)
@@ -109,10 +111,10 @@ func TestListProjects2(t *testing.T) {
 	ts := httptest.NewServer(http.HandlerFunc(listProjectsStub))
 	defer ts.Close()
 
-	client := bamboo.NewSimpleClient(nil, "", "")
+	client := bamboo.NewSimpleClient(nil, "", "", "")
 	client.SetURL(ts.URL)
 
-	result, response, err := client.Projects.ListProjects()
+	result, response, err := client.Projects.ListProjects(nil)
 	assert.NoError(t, err)
 	assert.NotNil(t, response)
 	assert.Equal(t, http.StatusOK, response.StatusCode)
@@ -135,3 +137,429 @@ func listProjectsStub(w http.ResponseWriter, r *http.Request) {
 func unauthorizedStub(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusUnauthorized)
 }
+
+func TestCreateProject(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(createProjectStub))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	project, response, err := client.Projects.CreateProject("NEW", "new project", "onboarded")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, response.StatusCode)
+	assert.Equal(t, "NEW", project.Key)
+
+	_, _, err = client.Projects.CreateProject("", "new project", "")
+	assert.Error(t, err)
+}
+
+func createProjectStub(w http.ResponseWriter, r *http.Request) {
+	project := bamboo.Project{}
+	json.NewDecoder(r.Body).Decode(&project)
+
+	if r.Method != http.MethodPost || r.URL.Path != "/rest/api/latest/project" || project.Name != "new project" {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	bytes, err := json.Marshal(project)
+	if err != nil {
+		panic(err)
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	w.Write(bytes)
+}
+
+func TestDeleteProject(t *testing.T) {
+	deleted := false
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodDelete {
+			deleted = r.URL.Path == "/rest/api/latest/project/ABC"
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		resp := bamboo.ProjectInformation{Key: "ABC", NumPlans: &bamboo.ProjectPlansInformation{Size: 2}}
+		bytes, _ := json.Marshal(resp)
+		w.Write(bytes)
+	}))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	_, err := client.Projects.DeleteProject("ABC", nil)
+	assert.Error(t, err)
+	assert.False(t, deleted)
+
+	response, err := client.Projects.DeleteProject("ABC", &bamboo.ProjectDeleteOptions{DeletePlans: true})
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, response.StatusCode)
+	assert.True(t, deleted)
+}
+
+func TestUpdateProject(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(updateProjectStub))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	project, response, err := client.Projects.UpdateProject("ABC", &bamboo.ProjectUpdate{Description: "synced"})
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	assert.Equal(t, "first project", project.Name)
+	assert.Equal(t, "synced", project.Description)
+}
+
+func updateProjectStub(w http.ResponseWriter, r *http.Request) {
+	project := bamboo.Project{Key: "ABC", Name: "first project", Description: "long description"}
+	fields := bamboo.ProjectUpdate{}
+	json.NewDecoder(r.Body).Decode(&fields)
+
+	if r.Method != http.MethodPut || r.URL.Path != "/rest/api/latest/project/ABC" {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	if fields.Name != "" {
+		project.Name = fields.Name
+	}
+	if fields.Description != "" {
+		project.Description = fields.Description
+	}
+
+	bytes, err := json.Marshal(project)
+	if err != nil {
+		panic(err)
+	}
+
+	w.Write(bytes)
+}
+
+func TestAddRemoveRepository(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(projectRepositoryStub))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	repository, response, err := client.Projects.AddRepository("ABC", &bamboo.ProjectRepos{Name: "shared", Type: "git"})
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, response.StatusCode)
+	assert.Equal(t, 7, repository.Id)
+
+	response, err = client.Projects.RemoveRepository("ABC", repository.Id)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, response.StatusCode)
+}
+
+func projectRepositoryStub(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case r.Method == http.MethodPost && r.URL.Path == "/rest/api/latest/project/ABC/repositories":
+		repository := bamboo.ProjectRepos{}
+		json.NewDecoder(r.Body).Decode(&repository)
+		repository.Id = 7
+
+		bytes, err := json.Marshal(repository)
+		if err != nil {
+			panic(err)
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		w.Write(bytes)
+	case r.Method == http.MethodDelete && r.URL.Path == "/rest/api/latest/project/ABC/repositories/7":
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusBadRequest)
+	}
+}
+
+func TestProjectRepositories(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(projectRepositoriesStub))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	repositories, _, err := client.Projects.ProjectRepositories("ABC", &bamboo.Pagination{Start: 10, Limit: 5})
+	assert.NoError(t, err)
+	assert.Equal(t, 5, len(repositories))
+	assert.Equal(t, 10, repositories[0].Id)
+
+	repositories, _, err = client.Projects.AllProjectRepositories("ABC")
+	assert.NoError(t, err)
+	assert.Equal(t, 230, len(repositories))
+	assert.Equal(t, 229, repositories[229].Id)
+}
+
+func projectRepositoriesStub(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/rest/api/latest/project/ABC/repositories" {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	start, _ := strconv.Atoi(r.URL.Query().Get("start"))
+	limit, err := strconv.Atoi(r.URL.Query().Get("limit"))
+	if err != nil {
+		limit = 25
+	}
+
+	repositories := []*bamboo.ProjectRepos{}
+	for i := start; i < start+limit && i < 230; i++ {
+		repositories = append(repositories, &bamboo.ProjectRepos{Id: i})
+	}
+
+	resp := bamboo.ProjectRepositoryResult{Index: &bamboo.Index{Start: start, Limit: limit}, Repositories: repositories}
+	bytes, err := json.Marshal(resp)
+	if err != nil {
+		panic(err)
+	}
+
+	w.Write(bytes)
+}
+
+func TestSpecsAccess(t *testing.T) {
+	allowed := map[int]bool{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		specsAccessStub(w, r, allowed)
+	}))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	_, err := client.Projects.GrantSpecsAccess("ABC", 3)
+	assert.NoError(t, err)
+
+	repositories, _, err := client.Projects.SpecsAccessRepositories("ABC")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(repositories))
+	assert.Equal(t, 3, repositories[0].Id)
+
+	_, err = client.Projects.RevokeSpecsAccess("ABC", 3)
+	assert.NoError(t, err)
+	assert.Empty(t, allowed)
+}
+
+func specsAccessStub(w http.ResponseWriter, r *http.Request, allowed map[int]bool) {
+	const base = "/rest/api/latest/project/ABC/specs/repositories"
+
+	switch {
+	case r.Method == http.MethodPost && r.URL.Path == base:
+		repository := bamboo.ProjectRepos{}
+		json.NewDecoder(r.Body).Decode(&repository)
+		allowed[repository.Id] = true
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodGet && r.URL.Path == base:
+		resp := bamboo.ProjectRepositoryResult{}
+		for id := range allowed {
+			resp.Repositories = append(resp.Repositories, &bamboo.ProjectRepos{Id: id})
+		}
+
+		bytes, err := json.Marshal(resp)
+		if err != nil {
+			panic(err)
+		}
+		w.Write(bytes)
+	case r.Method == http.MethodDelete && strings.HasPrefix(r.URL.Path, base+"/"):
+		id, _ := strconv.Atoi(strings.TrimPrefix(r.URL.Path, base+"/"))
+		delete(allowed, id)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusBadRequest)
+	}
+}
+
+func TestProjectPlansPagination(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(manyProjectPlansStub))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	plans, _, err := client.Projects.ProjectPlans("ABC", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 1234, len(plans))
+
+	plans, _, err = client.Projects.ProjectPlans("ABC", &bamboo.Pagination{Start: 1200, Limit: 50})
+	assert.NoError(t, err)
+	assert.Equal(t, 34, len(plans))
+	assert.Equal(t, "ABC-P1200", plans[0].Key)
+}
+
+func manyProjectPlansStub(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	if r.URL.Path != "/rest/api/latest/project/ABC.json" || q.Get("expand") != "plans" {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	start, _ := strconv.Atoi(q.Get("start-index"))
+	max, err := strconv.Atoi(q.Get("max-results"))
+	if err != nil {
+		max = 25
+	}
+
+	planList := []*bamboo.Plan{}
+	for i := start; i < start+max && i < 1234; i++ {
+		planList = append(planList, &bamboo.Plan{Key: "ABC-P" + strconv.Itoa(i)})
+	}
+
+	resp := bamboo.PlanResponse{Plans: &bamboo.Plans{
+		CollectionMetadata: &bamboo.CollectionMetadata{Size: 1234, StartIndex: start, MaxResult: max},
+		PlanList:           planList,
+	}}
+	bytes, err := json.Marshal(resp)
+	if err != nil {
+		panic(err)
+	}
+
+	w.Write(bytes)
+}
+
+func TestProjectStatus(t *testing.T) {
+	requests := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		projectStatusStub(w, r)
+	}))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	status, _, err := client.Projects.ProjectStatus("ABC")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, status.Successful)
+	assert.Equal(t, 1, status.Failing)
+	assert.Equal(t, 1, status.Disabled)
+	assert.Equal(t, 1, status.NotBuilt)
+	assert.Equal(t, bamboo.FailedBuildState, status.PlanStates["ABC-RED"])
+	assert.Equal(t, bamboo.SuccessfulBuildState, status.PlanStates["ABC-QUIET"])
+
+	// The plans, the project results and both pages of the latest results, nothing per plan
+	assert.Equal(t, 4, requests)
+}
+
+func TestProjectStatusWithoutPlans(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/latest/project/EMPTY.json" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Write([]byte(`{"plans":{"size":0,"plan":[]}}`))
+	}))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	status, _, err := client.Projects.ProjectStatus("EMPTY")
+	assert.NoError(t, err)
+	assert.Empty(t, status.PlanStates)
+}
+
+func projectStatusStub(w http.ResponseWriter, r *http.Request) {
+	var resp interface{}
+	switch r.URL.Path {
+	case "/rest/api/latest/project/ABC.json":
+		resp = bamboo.PlanResponse{Plans: &bamboo.Plans{PlanList: []*bamboo.Plan{
+			&bamboo.Plan{Key: "ABC-GREEN", Enabled: true},
+			&bamboo.Plan{Key: "ABC-RED", Enabled: true},
+			&bamboo.Plan{Key: "ABC-OFF", Enabled: false},
+			&bamboo.Plan{Key: "ABC-NEW", Enabled: true},
+			&bamboo.Plan{Key: "ABC-QUIET", Enabled: true},
+		}}}
+	case "/rest/api/latest/result/ABC.json":
+		if r.URL.Query().Get("max-results") != "100" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		// ABC-QUIET was built long ago and is crowded out by the other plans
+		resp = bamboo.ResultsResponse{Results: &bamboo.Results{ResultList: []*bamboo.Result{
+			&bamboo.Result{BuildResultKey: "ABC-GREEN-12", BuildNumber: 12, BuildState: bamboo.SuccessfulBuildState},
+			&bamboo.Result{BuildResultKey: "ABC-GREEN-11", BuildNumber: 11, BuildState: bamboo.FailedBuildState},
+			&bamboo.Result{BuildResultKey: "ABC-RED-4", BuildNumber: 4, BuildState: bamboo.FailedBuildState},
+			&bamboo.Result{BuildResultKey: "ABC-OFF-1", BuildNumber: 1, BuildState: bamboo.FailedBuildState},
+		}}}
+	case "/rest/api/latest/result.json":
+		// The latest result of each plan on the server, two per page, ABC-NEW was never built
+		results := &bamboo.Results{
+			CollectionMetadata: &bamboo.CollectionMetadata{Size: 4, MaxResult: 2},
+			ResultList: []*bamboo.Result{
+				&bamboo.Result{BuildResultKey: "ABC-GREEN-12", BuildNumber: 12, BuildState: bamboo.SuccessfulBuildState},
+				&bamboo.Result{BuildResultKey: "XYZ-OTHER-7", BuildNumber: 7, BuildState: bamboo.FailedBuildState},
+			},
+		}
+		if r.URL.Query().Get("start-index") == "2" {
+			results.StartIndex = 2
+			results.ResultList = []*bamboo.Result{
+				&bamboo.Result{BuildResultKey: "ABC-QUIET-3", BuildNumber: 3, BuildState: bamboo.SuccessfulBuildState},
+				&bamboo.Result{BuildResultKey: "ABC-RED-4", BuildNumber: 4, BuildState: bamboo.FailedBuildState},
+			}
+		}
+		resp = bamboo.ResultsResponse{Results: results}
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	bytes, err := json.Marshal(resp)
+	if err != nil {
+		panic(err)
+	}
+
+	w.Write(bytes)
+}
+
+func TestListProjectsWithOptions(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(manyProjectsStub))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	options := &bamboo.ProjectListOptions{Expand: "projects.project.description"}
+	projects, _, err := client.Projects.ListProjects(options)
+	assert.NoError(t, err)
+	assert.Equal(t, 260, len(projects))
+	assert.Equal(t, "P259 description", projects[259].Description)
+
+	options.Page = &bamboo.Pagination{Start: 25, Limit: 25}
+	projects, _, err = client.Projects.ListProjects(options)
+	assert.NoError(t, err)
+	assert.Equal(t, 25, len(projects))
+	assert.Equal(t, "P25", projects[0].Key)
+}
+
+func manyProjectsStub(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	start, _ := strconv.Atoi(q.Get("start-index"))
+	max, err := strconv.Atoi(q.Get("max-results"))
+	if err != nil {
+		max = 25
+	}
+
+	projectList := []*bamboo.Project{}
+	for i := start; i < start+max && i < 260; i++ {
+		project := &bamboo.Project{Key: "P" + strconv.Itoa(i)}
+		if q.Get("expand") == "projects.project.description" {
+			project.Description = project.Key + " description"
+		}
+		projectList = append(projectList, project)
+	}
+
+	resp := bamboo.ProjectResponse{Projects: &bamboo.Projects{
+		CollectionMetadata: &bamboo.CollectionMetadata{Size: 260, StartIndex: start, MaxResult: max},
+		ProjectList:        projectList,
+	}}
+	bytes, err := json.Marshal(resp)
+	if err != nil {
+		panic(err)
+	}
+
+	w.Write(bytes)
+}
@@ -0,0 +1,276 @@
+package bamboo
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// QueueService handles communication with the build queue
+type QueueService service
+
+// QueueBuildOptions specifies the optional parameters
+// for the QueueBuild method
+// - Variables:        Plan variables to override for the build, keyed without the "bamboo.variable." prefix
+// - Stage:            Run the plan up to and including this manual stage
+// - ExecuteAllStages: Run every stage of the plan, including manual stages
+// - CustomRevision:   Build this VCS revision, e.g. a commit SHA, instead of the latest one
+type QueueBuildOptions struct {
+	Variables        map[string]string
+	Stage            string
+	ExecuteAllStages bool
+	CustomRevision   string
+}
+
+// QueuedBuild holds the information of a build added to the build queue
+type QueuedBuild struct {
+	PlanKey        string `json:"planKey"`
+	BuildNumber    int    `json:"buildNumber"`
+	BuildResultKey string `json:"buildResultKey"`
+	TriggerReason  string `json:"triggerReason"`
+	Link           *Link  `json:"link,omitempty"`
+}
+
+// QueueBuild triggers a build of the given plan or plan branch
+func (q *QueueService) QueueBuild(planKey string, options *QueueBuildOptions) (*QueuedBuild, *http.Response, error) {
+	if emptyStrings(planKey) {
+		return nil, nil, &simpleError{"Plan key cannot be empty"}
+	}
+
+	request, err := q.client.NewRequest(http.MethodPost, fmt.Sprintf("queue/%s.json", planKey), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if options != nil {
+		values := request.URL.Query()
+		for name, value := range options.Variables {
+			values.Set("bamboo.variable."+name, value)
+		}
+		if options.Stage != "" {
+			values.Set("stage", options.Stage)
+		}
+		if options.ExecuteAllStages {
+			values.Set("executeAllStages", "true")
+		}
+		if options.CustomRevision != "" {
+			values.Set("customRevision", options.CustomRevision)
+		}
+		request.URL.RawQuery = values.Encode()
+	}
+
+	queued := QueuedBuild{}
+	response, err := q.client.Do(request, &queued)
+	if err != nil {
+		return nil, response, err
+	}
+
+	if response.StatusCode != 200 {
+		return nil, response, &simpleError{fmt.Sprintf("Queueing a build of %s returned %s", planKey, response.Status)}
+	}
+
+	return &queued, response, nil
+}
+
+// stopPlanURL is the action stopping a running build, relative to the REST API base URL.
+// The REST API can only remove builds from the queue.
+const stopPlanURL = "../../../build/admin/stopPlan.action"
+
+// DequeueBuild removes the given build, e.g. "PROJ-PLAN-123", from the build queue.
+// Builds which are already running aren't affected, see StopBuild.
+func (q *QueueService) DequeueBuild(resultKey string) (*http.Response, error) {
+	if _, _, ok := splitResultKey(resultKey); !ok {
+		return nil, &simpleError{fmt.Sprintf("%q is not a result key", resultKey)}
+	}
+
+	request, err := q.client.NewRequest(http.MethodDelete, fmt.Sprintf("queue/%s", resultKey), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := q.client.Do(request, nil)
+	if err != nil {
+		return response, err
+	}
+
+	if response.StatusCode != 200 && response.StatusCode != 204 {
+		return response, &simpleError{fmt.Sprintf("Removing build %s from the queue returned %s", resultKey, response.Status)}
+	}
+
+	return response, nil
+}
+
+// StopBuild stops the given queued or running build, e.g. "PROJ-PLAN-123".
+// The stop action answers with a redirect to the plan or result page, any other answer such as
+// a redirect to the login page means the build wasn't stopped.
+func (q *QueueService) StopBuild(resultKey string) (*http.Response, error) {
+	planKey, _, ok := splitResultKey(resultKey)
+	if !ok {
+		return nil, &simpleError{fmt.Sprintf("%q is not a result key", resultKey)}
+	}
+
+	request, err := q.client.NewRequest(http.MethodPost, stopPlanURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	// The action is protected against cross site requests unless this header is sent
+	request.Header.Set("X-Atlassian-Token", "no-check")
+
+	values := request.URL.Query()
+	values.Set("planResultKey", resultKey)
+	request.URL.RawQuery = values.Encode()
+
+	// Following the redirect would hide where the action sent us
+	noRedirect := *q.client.client
+	noRedirect.CheckRedirect = func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	}
+	response, err := noRedirect.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	response.Body.Close()
+
+	if response.StatusCode != http.StatusFound {
+		return response, &simpleError{fmt.Sprintf("Stopping build %s returned %s", resultKey, response.Status)}
+	}
+
+	location, err := response.Location()
+	if err != nil || !strings.Contains(location.Path, "/browse/"+planKey) {
+		return response, &simpleError{fmt.Sprintf("Stopping build %s redirected to %s", resultKey, response.Header.Get("Location"))}
+	}
+
+	return response, nil
+}
+
+// Reorder moves the given queued build, e.g. "PROJ-PLAN-123", to the given zero based
+// position in the build queue
+func (q *QueueService) Reorder(resultKey string, position int) (*http.Response, error) {
+	if _, _, ok := splitResultKey(resultKey); !ok || position < 0 {
+		return nil, &simpleError{fmt.Sprintf("Can't move %q to position %d of the queue", resultKey, position)}
+	}
+
+	request, err := q.client.NewRequest(http.MethodPost, "queue/reorder", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	values := request.URL.Query()
+	values.Set("resultKey", resultKey)
+	values.Set("position", strconv.Itoa(position))
+	request.URL.RawQuery = values.Encode()
+
+	response, err := q.client.Do(request, nil)
+	if err != nil {
+		return response, err
+	}
+
+	if response.StatusCode != 200 && response.StatusCode != 204 {
+		return response, &simpleError{fmt.Sprintf("Moving build %s in the queue returned %s", resultKey, response.Status)}
+	}
+
+	return response, nil
+}
+
+// MoveToTop moves the given queued build, e.g. "PROJ-PLAN-123", to the front of the build queue
+func (q *QueueService) MoveToTop(resultKey string) (*http.Response, error) {
+	return q.Reorder(resultKey, 0)
+}
+
+// QueuedBuilds is the collection of builds waiting in the build queue
+type QueuedBuilds struct {
+	*CollectionMetadata
+	QueuedBuildList []*QueuedBuild `json:"queuedBuild"`
+}
+
+type queueResponse struct {
+	QueuedBuilds *QueuedBuilds `json:"queuedBuilds"`
+}
+
+// PendingBuilds returns the builds of the given plan or plan branch which are
+// queued or waiting for an agent
+func (q *QueueService) PendingBuilds(planKey string) ([]*QueuedBuild, *http.Response, error) {
+	if emptyStrings(planKey) {
+		return nil, nil, &simpleError{"Plan key cannot be empty"}
+	}
+
+	queued, response, err := q.queuedBuilds()
+	if err != nil {
+		return nil, response, err
+	}
+
+	// The queue resource can't be filtered by plan, so the builds of other plans are dropped here
+	pending := []*QueuedBuild{}
+	for _, build := range queued {
+		if build.PlanKey == planKey {
+			pending = append(pending, build)
+		}
+	}
+
+	return pending, response, nil
+}
+
+// queuedBuilds returns every build in the build queue
+func (q *QueueService) queuedBuilds() ([]*QueuedBuild, *http.Response, error) {
+	request, err := q.client.NewRequest(http.MethodGet, "queue.json", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	values := request.URL.Query()
+	values.Set("expand", "queuedBuilds")
+	request.URL.RawQuery = values.Encode()
+
+	queue := queueResponse{}
+	response, err := q.client.Do(request, &queue)
+	if err != nil {
+		return nil, response, err
+	}
+
+	if response.StatusCode != 200 {
+		return nil, response, &simpleError{fmt.Sprintf("Listing the build queue returned %s", response.Status)}
+	}
+
+	if queue.QueuedBuilds == nil {
+		return []*QueuedBuild{}, response, nil
+	}
+	return queue.QueuedBuilds.QueuedBuildList, response, nil
+}
+
+// QueuedDeployments is the collection of deployments waiting in the deployment queue
+type QueuedDeployments struct {
+	*CollectionMetadata
+	QueuedDeploymentList []*QueueDeployRequest `json:"queuedDeployment"`
+}
+
+type deploymentQueueResponse struct {
+	QueuedDeployments *QueuedDeployments `json:"queuedDeployments"`
+}
+
+// ListQueuedDeployments returns the deployments which are queued or waiting for an agent
+func (q *QueueService) ListQueuedDeployments() ([]*QueueDeployRequest, *http.Response, error) {
+	request, err := q.client.NewRequest(http.MethodGet, "queue/deployment", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	values := request.URL.Query()
+	values.Set("expand", "queuedDeployments")
+	request.URL.RawQuery = values.Encode()
+
+	queue := deploymentQueueResponse{}
+	response, err := q.client.Do(request, &queue)
+	if err != nil {
+		return nil, response, err
+	}
+
+	if response.StatusCode != 200 {
+		return nil, response, &simpleError{fmt.Sprintf("Listing the deployment queue returned %s", response.Status)}
+	}
+
+	if queue.QueuedDeployments == nil {
+		return []*QueueDeployRequest{}, response, nil
+	}
+	return queue.QueuedDeployments.QueuedDeploymentList, response, nil
+}
@@ -0,0 +1,197 @@
+package bamboo_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	bamboo "github.com/sukhyun/go-bamboo"
+)
+
+func TestQueueBuild(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(queueBuildStub))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	options := &bamboo.QueueBuildOptions{
+		Variables:        map[string]string{"release": "1.2.0"},
+		Stage:            "Deploy",
+		ExecuteAllStages: true,
+	}
+	queued, response, err := client.Queue.QueueBuild("CORE-TEST", options)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	assert.Equal(t, "CORE-TEST-8", queued.BuildResultKey)
+}
+
+func queueBuildStub(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	if r.Method != http.MethodPost || r.URL.Path != "/rest/api/latest/queue/CORE-TEST.json" ||
+		q.Get("bamboo.variable.release") != "1.2.0" || q.Get("stage") != "Deploy" || q.Get("executeAllStages") != "true" {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	resp := bamboo.QueuedBuild{PlanKey: "CORE-TEST", BuildNumber: 8, BuildResultKey: "CORE-TEST-8", TriggerReason: "Manual build"}
+	bytes, err := json.Marshal(resp)
+	if err != nil {
+		panic(err)
+	}
+
+	w.Write(bytes)
+}
+
+func TestQueueBuildCustomRevision(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("customRevision") != "4b825dc642cb6eb9a060e54bf8d69288fbee4904" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Write([]byte(`{"planKey":"CORE-TEST","buildNumber":9,"buildResultKey":"CORE-TEST-9"}`))
+	}))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	options := &bamboo.QueueBuildOptions{CustomRevision: "4b825dc642cb6eb9a060e54bf8d69288fbee4904"}
+	queued, _, err := client.Queue.QueueBuild("CORE-TEST", options)
+	assert.NoError(t, err)
+	assert.Equal(t, 9, queued.BuildNumber)
+}
+
+func TestStopBuild(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/build/admin/stopPlan.action" ||
+			r.Header.Get("X-Atlassian-Token") != "no-check" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		switch r.URL.Query().Get("planResultKey") {
+		case "CORE-TEST-8":
+			http.Redirect(w, r, "/browse/CORE-TEST-8", http.StatusFound)
+		case "CORE-TEST-9":
+			// Not logged in or XSRF check failed, the login page answers with 200 when followed
+			http.Redirect(w, r, "/userlogin!doDefault.action", http.StatusFound)
+		default:
+			w.Write([]byte("<html></html>"))
+		}
+	}))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	_, err := client.Queue.StopBuild("CORE-TEST-8")
+	assert.NoError(t, err)
+
+	_, err = client.Queue.StopBuild("CORE-TEST-9")
+	assert.Error(t, err)
+
+	_, err = client.Queue.StopBuild("CORE-TEST-10")
+	assert.Error(t, err)
+
+	_, err = client.Queue.StopBuild("CORE-TEST")
+	assert.Error(t, err)
+}
+
+func TestDequeueBuild(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/rest/api/latest/queue/CORE-TEST-8" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	response, err := client.Queue.DequeueBuild("CORE-TEST-8")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, response.StatusCode)
+
+	_, err = client.Queue.DequeueBuild("CORE-TEST")
+	assert.Error(t, err)
+}
+
+func TestPendingBuilds(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(pendingBuildsStub))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	pending, _, err := client.Queue.PendingBuilds("CORE-TEST")
+	assert.NoError(t, err)
+	if assert.Len(t, pending, 1) {
+		assert.Equal(t, "CORE-TEST-10", pending[0].BuildResultKey)
+	}
+
+	_, _, err = client.Queue.PendingBuilds("")
+	assert.Error(t, err)
+}
+
+func pendingBuildsStub(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/rest/api/latest/queue.json" || r.URL.Query().Get("expand") != "queuedBuilds" {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	w.Write([]byte(`{"queuedBuilds":{"size":2,"start-index":0,"max-result":2,"queuedBuild":[` +
+		`{"planKey":"CORE-TEST","buildNumber":10,"buildResultKey":"CORE-TEST-10","triggerReason":"Manual build"},` +
+		`{"planKey":"CORE-OTHER","buildNumber":3,"buildResultKey":"CORE-OTHER-3","triggerReason":"Code has changed"}]}}`))
+}
+
+func TestReorder(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if r.Method != http.MethodPost || r.URL.Path != "/rest/api/latest/queue/reorder" || q.Get("resultKey") != "CORE-TEST-10" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if q.Get("position") != "0" && q.Get("position") != "3" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	_, err := client.Queue.MoveToTop("CORE-TEST-10")
+	assert.NoError(t, err)
+	_, err = client.Queue.Reorder("CORE-TEST-10", 3)
+	assert.NoError(t, err)
+	_, err = client.Queue.Reorder("CORE-TEST-10", -1)
+	assert.Error(t, err)
+}
+
+func TestListQueuedDeployments(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/latest/queue/deployment" || r.URL.Query().Get("expand") != "queuedDeployments" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Write([]byte(`{"queuedDeployments":{"size":2,"queuedDeployment":[
+			{"deploymentResultId":2049,"link":{"href":"http://bamboo/rest/api/latest/deploy/result/2049","rel":"self"}},
+			{"deploymentResultId":2050}]}}`))
+	}))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	deployments, _, err := client.Queue.ListQueuedDeployments()
+	assert.NoError(t, err)
+	if assert.Len(t, deployments, 2) {
+		assert.Equal(t, 2050, deployments[1].DeploymentResultID)
+	}
+}
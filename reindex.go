@@ -0,0 +1,16 @@
+package bamboo
+
+import "net/http"
+
+// ReindexService handles rebuilding the Bamboo search index
+type ReindexService service
+
+// Start starts rebuilding the search index, e.g. after an upgrade
+func (r *ReindexService) Start() (*ReindexState, *http.Response, error) {
+	return (*ServerService)(r).Reindex()
+}
+
+// Status returns whether a reindex is in progress or pending
+func (r *ReindexService) Status() (*ReindexState, *http.Response, error) {
+	return (*ServerService)(r).ReindexStatus()
+}
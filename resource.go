@@ -28,8 +28,22 @@ type CollectionMetadata struct {
 	MaxResult  int    `json:"max-result"`
 }
 
+// hasMore reports whether the collection holds resources after the given index
+func (c *CollectionMetadata) hasMore(next int) bool {
+	return c != nil && next < c.Size
+}
+
+// Index holds the paging information of a paginated resource
+// - Self:  URL of the requested page
+// - Start: Index of the first resource in the page
+// - Limit: The maximum number of resources in the page
 type Index struct {
-	Self string `json:"self"`
-	Start int `json:"start"`
-	Limit int `json:"limit"`
+	Self  string `json:"self"`
+	Start int    `json:"start"`
+	Limit int    `json:"limit"`
+}
+
+// hasMore reports whether a page holding the given number of resources may be followed by another page
+func (i *Index) hasMore(pageSize int) bool {
+	return i != nil && i.Limit > 0 && pageSize >= i.Limit
 }
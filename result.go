@@ -1,10 +1,40 @@
 package bamboo
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
 )
 
+// SuccessfulBuildState is the build state of a successful build
+const SuccessfulBuildState string = "Successful"
+
+// FailedBuildState is the build state of a failed build
+const FailedBuildState string = "Failed"
+
+// UnknownBuildState is the build state of a build which hasn't finished or was stopped
+const UnknownBuildState string = "Unknown"
+
+// FinishedLifeCycleState is the life cycle state of a build which has completed
+const FinishedLifeCycleState string = "Finished"
+
+// InProgressLifeCycleState is the life cycle state of a build which is running on an agent
+const InProgressLifeCycleState string = "InProgress"
+
+// PendingLifeCycleState is the life cycle state of a build which is waiting to be queued
+const PendingLifeCycleState string = "Pending"
+
+// QueuedLifeCycleState is the life cycle state of a build which is waiting for an agent
+const QueuedLifeCycleState string = "Queued"
+
+// NotBuiltLifeCycleState is the life cycle state of a job which didn't run, e.g. behind a manual stage
+const NotBuiltLifeCycleState string = "NotBuilt"
+
 // ResultService handles communication with build results
 type ResultService service
 
@@ -24,29 +54,231 @@ type Results struct {
 // Result represents all the information associated with a build result
 type Result struct {
 	ChangeSet              `json:"changes"`
-	ID                     int    `json:"id"`
-	PlanName               string `json:"planName"`
-	ProjectName            string `json:"projectName"`
-	BuildResultKey         string `json:"buildResultKey"`
-	LifeCycleState         string `json:"lifeCycleState"`
-	BuildStartedTime       string `json:"buildStartedTime"`
-	BuildCompletedTime     string `json:"buildCompletedTime"`
-	BuildDurationInSeconds int    `json:"buildDurationInSeconds"`
-	VcsRevisionKey         string `json:"vcsRevisionKey"`
-	BuildTestSummary       string `json:"buildTestSummary"`
-	SuccessfulTestCount    int    `json:"successfulTestCount"`
-	FailedTestCount        int    `json:"failedTestCount"`
-	QuarantinedTestCount   int    `json:"quarantinedTestCount"`
-	SkippedTestCount       int    `json:"skippedTestCount"`
-	Finished               bool   `json:"finished"`
-	Successful             bool   `json:"successful"`
-	BuildReason            string `json:"buildReason"`
-	ReasonSummary          string `json:"reasonSummary"`
-	Key                    string `json:"key"`
-	State                  string `json:"state"`
-	BuildState             string `json:"buildState"`
-	Number                 int    `json:"number"`
-	BuildNumber            int    `json:"buildNumber"`
+	ID                     int           `json:"id"`
+	PlanName               string        `json:"planName"`
+	ProjectName            string        `json:"projectName"`
+	BuildResultKey         string        `json:"buildResultKey"`
+	LifeCycleState         string        `json:"lifeCycleState"`
+	BuildStartedTime       string        `json:"buildStartedTime"`
+	BuildCompletedTime     string        `json:"buildCompletedTime"`
+	BuildDurationInSeconds int           `json:"buildDurationInSeconds"`
+	BuildDuration          int64         `json:"buildDuration"`
+	BuildRelativeTime      string        `json:"buildRelativeTime"`
+	VcsRevisionKey         string        `json:"vcsRevisionKey"`
+	BuildTestSummary       string        `json:"buildTestSummary"`
+	SuccessfulTestCount    int           `json:"successfulTestCount"`
+	FailedTestCount        int           `json:"failedTestCount"`
+	QuarantinedTestCount   int           `json:"quarantinedTestCount"`
+	SkippedTestCount       int           `json:"skippedTestCount"`
+	Finished               bool          `json:"finished"`
+	Successful             bool          `json:"successful"`
+	BuildReason            string        `json:"buildReason"`
+	ReasonSummary          string        `json:"reasonSummary"`
+	Key                    string        `json:"key"`
+	State                  string        `json:"state"`
+	BuildState             string        `json:"buildState"`
+	Number                 int           `json:"number"`
+	BuildNumber            int           `json:"buildNumber"`
+	Continuable            bool          `json:"continuable"`
+	Restartable            bool          `json:"restartable"`
+	NotRunYet              bool          `json:"notRunYet"`
+	Stages                 *StageResults `json:"stages,omitempty"`
+	JiraIssues             *JiraIssues   `json:"jiraIssues,omitempty"`
+	Metadata               *Metadata     `json:"metadata,omitempty"`
+}
+
+// Metadata is the custom data of a build result
+type Metadata struct {
+	*CollectionMetadata
+	Items []*MetadataItem `json:"item"`
+}
+
+// MetadataItem is a single custom data entry of a build result
+type MetadataItem struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// StageResults is the collection of stage results of a chain result
+type StageResults struct {
+	*CollectionMetadata
+	StageList []*StageResult `json:"stage"`
+}
+
+// StageResult is the outcome of a single stage of a chain result
+// - Results: The results of the stage's jobs
+type StageResult struct {
+	ID             int      `json:"id"`
+	Name           string   `json:"name"`
+	Description    string   `json:"description,omitempty"`
+	Manual         bool     `json:"manual"`
+	State          string   `json:"state"`
+	LifeCycleState string   `json:"lifeCycleState"`
+	Results        *Results `json:"results,omitempty"`
+	jobs           []*JobResult
+}
+
+// UnmarshalJSON decodes the stage and additionally keeps its job results with their agents,
+// see ChainResult.Jobs
+func (s *StageResult) UnmarshalJSON(data []byte) error {
+	type stageResult StageResult
+	if err := json.Unmarshal(data, (*stageResult)(s)); err != nil {
+		return err
+	}
+
+	jobs := struct {
+		Results *JobResults `json:"results"`
+	}{}
+	if err := json.Unmarshal(data, &jobs); err != nil {
+		return err
+	}
+	s.jobs = nil
+	if jobs.Results != nil {
+		s.jobs = jobs.Results.ResultList
+	}
+	return nil
+}
+
+// JobResults is the collection of job results of a stage
+type JobResults struct {
+	*CollectionMetadata
+	ResultList []*JobResult `json:"result"`
+}
+
+// JobResult is the result of a single job of a chain result
+// - Agent: The agent which ran the job, nil if the job hasn't been picked up yet
+type JobResult struct {
+	*Result
+	Agent *ResultAgent `json:"agent,omitempty"`
+}
+
+// ResultAgent identifies the agent which ran a job
+type ResultAgent struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+	Type string `json:"type,omitempty"`
+}
+
+// ChainResult is a result of a plan together with its stages and job results
+type ChainResult struct {
+	*Result
+}
+
+// Jobs returns the job results of every stage of the chain, in stage order
+func (c *ChainResult) Jobs() []*JobResult {
+	jobs := []*JobResult{}
+	if c.Result == nil || c.Stages == nil {
+		return jobs
+	}
+
+	for _, stage := range c.Stages.StageList {
+		switch {
+		case stage.jobs != nil:
+			jobs = append(jobs, stage.jobs...)
+		case stage.Results != nil:
+			// Not decoded from a response, there's no agent to add
+			for _, result := range stage.Results.ResultList {
+				jobs = append(jobs, &JobResult{Result: result})
+			}
+		}
+	}
+	return jobs
+}
+
+// FailedJobs returns the job results of the chain which failed
+func (c *ChainResult) FailedJobs() []*JobResult {
+	failed := []*JobResult{}
+	for _, job := range c.Jobs() {
+		if job.Result != nil && job.BuildState == FailedBuildState {
+			failed = append(failed, job)
+		}
+	}
+	return failed
+}
+
+// ResultListOptions specifies the optional parameters
+// for listing build results
+// - Page:             Only return the given page of results, defaults to the server's first page
+// - Expand:           Elements of the results to expand, e.g. "results.result.labels"
+// - BuildState:       Only return results in the given build state, e.g. FailedBuildState
+// - Label:            Only return results with the given label
+// - IssueKey:         Only return results linked to the given JIRA issue
+// - IncludeAllStates: Also return results which haven't finished yet
+// - LifeCycleState:   Only return results in the given life cycle state, e.g. InProgressLifeCycleState
+// - StartedAfter:     Only return results started after this time. Ignored if zero.
+// - StartedBefore:    Only return results started before this time. Ignored if zero.
+// - ExpandStages:     Include the stages and job results of each result
+//
+// Bamboo can't filter on start time, so StartedAfter and StartedBefore are applied client side
+// while paging through every result. They can't be combined with Page. LifeCycleState is also
+// checked on the returned results, as older servers ignore it.
+type ResultListOptions struct {
+	Page             *Pagination
+	Expand           string
+	BuildState       string
+	Label            string
+	IssueKey         string
+	IncludeAllStates bool
+	LifeCycleState   string
+	StartedAfter     time.Time
+	StartedBefore    time.Time
+	ExpandStages     bool
+}
+
+func (o *ResultListOptions) setQuery(values url.Values) {
+	if o == nil {
+		return
+	}
+	o.Page.setIndexQuery(values)
+	expand := o.Expand
+	if o.ExpandStages {
+		expand = joinExpand(expand, "results.result.stages.stage.results")
+	}
+	if expand != "" {
+		values.Set("expand", expand)
+	}
+	if o.BuildState != "" {
+		values.Set("buildstate", o.BuildState)
+	}
+	if o.Label != "" {
+		values.Set("label", o.Label)
+	}
+	if o.IssueKey != "" {
+		values.Set("issueKey", o.IssueKey)
+	}
+	if o.IncludeAllStates {
+		values.Set("includeAllStates", "true")
+	}
+	if o.LifeCycleState != "" {
+		values.Set("lifeCycleState", o.LifeCycleState)
+	}
+}
+
+// filtersByTime reports whether results are filtered on their start time
+func (o *ResultListOptions) filtersByTime() bool {
+	return o != nil && (!o.StartedAfter.IsZero() || !o.StartedBefore.IsZero())
+}
+
+// matches reports whether the result satisfies the filters Bamboo can't apply server side
+func (o *ResultListOptions) matches(result *Result) bool {
+	if o == nil {
+		return true
+	}
+	if o.LifeCycleState != "" && result.LifeCycleState != o.LifeCycleState {
+		return false
+	}
+	if o.StartedAfter.IsZero() && o.StartedBefore.IsZero() {
+		return true
+	}
+
+	started := result.StartedTime()
+	if started.IsZero() {
+		return false
+	}
+	if !o.StartedAfter.IsZero() && !started.After(o.StartedAfter) {
+		return false
+	}
+	return o.StartedBefore.IsZero() || started.Before(o.StartedBefore)
 }
 
 // ChangeSet represents a collection of type Change
@@ -55,9 +287,28 @@ type ChangeSet struct {
 }
 
 // Change represents the author and commit hash of a source code change
+// - Files: The files touched by the change, only set when requested with ResultChanges
 type Change struct {
-	Author      string `json:"author"`
-	ChangeSetID string `json:"changesetId"`
+	Author      string       `json:"author"`
+	UserName    string       `json:"userName,omitempty"`
+	FullName    string       `json:"fullName,omitempty"`
+	ChangeSetID string       `json:"changesetId"`
+	Comment     string       `json:"comment,omitempty"`
+	CommitURL   string       `json:"commitUrl,omitempty"`
+	Date        string       `json:"date,omitempty"`
+	Files       *ChangeFiles `json:"files,omitempty"`
+}
+
+// ChangeFiles is the collection of files touched by a change
+type ChangeFiles struct {
+	*CollectionMetadata
+	FileList []*ChangeFile `json:"file"`
+}
+
+// ChangeFile is a file touched by a change and its revision after the change
+type ChangeFile struct {
+	Name     string `json:"name"`
+	Revision string `json:"revision,omitempty"`
 }
 
 // LatestResult returns the latest result information for the given plan key
@@ -66,12 +317,33 @@ func (r *ResultService) LatestResult(key string) (*Result, *http.Response, error
 	return result, resp, err
 }
 
+// GetResult returns the result of the given build of a plan
+func (r *ResultService) GetResult(planKey string, buildNumber int) (*Result, *http.Response, error) {
+	if emptyStrings(planKey) {
+		return nil, nil, &simpleError{"Plan key cannot be empty"}
+	}
+	return r.NumberedResult(fmt.Sprintf("%s-%d", planKey, buildNumber))
+}
+
+// GetResultByKey returns the result with the given full result key, e.g. "PROJ-PLAN-123"
+func (r *ResultService) GetResultByKey(resultKey string) (*Result, *http.Response, error) {
+	if emptyStrings(resultKey) {
+		return nil, nil, &simpleError{"Result key cannot be empty"}
+	}
+	return r.NumberedResult(resultKey)
+}
+
 // NumberedResult returns the result information for the given plan key which includes the build number of the desired result
 func (r *ResultService) NumberedResult(key string) (*Result, *http.Response, error) {
+	return r.numberedResult(context.Background(), key)
+}
+
+func (r *ResultService) numberedResult(ctx context.Context, key string) (*Result, *http.Response, error) {
 	request, err := r.client.NewRequest(http.MethodGet, numberedResultURL(key), nil)
 	if err != nil {
 		return nil, nil, err
 	}
+	request = request.WithContext(ctx)
 
 	result := Result{}
 	response, err := r.client.Do(request, &result)
@@ -86,6 +358,28 @@ func (r *ResultService) NumberedResult(key string) (*Result, *http.Response, err
 	return &result, response, err
 }
 
+// resultSummary returns the given result without any expansions, which is enough to check
+// its state and build times
+func (r *ResultService) resultSummary(ctx context.Context, key string) (*Result, *http.Response, error) {
+	request, err := r.client.NewRequest(http.MethodGet, fmt.Sprintf("%s/%s.json", resultsBase, key), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	request = request.WithContext(ctx)
+
+	result := Result{}
+	response, err := r.client.Do(request, &result)
+	if err != nil {
+		return nil, response, err
+	}
+
+	if response.StatusCode != 200 {
+		return nil, response, &simpleError{fmt.Sprintf("Getting result %s returned %s", key, response.Status)}
+	}
+
+	return &result, response, nil
+}
+
 // NumberedResult returns the result information for the given plan key which includes the build number of the desired result
 func (r *ResultService) ListResults(key string) ([]*Result, *http.Response, error) {
 	request, err := r.client.NewRequest(http.MethodGet, listResultsURL(key), nil)
@@ -105,3 +399,507 @@ func (r *ResultService) ListResults(key string) ([]*Result, *http.Response, erro
 
 	return result.Results.ResultList, response, err
 }
+
+// Duration returns how long the build took
+func (r *Result) Duration() time.Duration {
+	if r.BuildDuration > 0 {
+		return time.Duration(r.BuildDuration) * time.Millisecond
+	}
+	return time.Duration(r.BuildDurationInSeconds) * time.Second
+}
+
+// StartedTime returns when the build started, or the zero time if it hasn't started yet
+func (r *Result) StartedTime() time.Time {
+	return parseResultTime(r.BuildStartedTime)
+}
+
+// CompletedTime returns when the build completed, or the zero time if it hasn't completed yet
+func (r *Result) CompletedTime() time.Time {
+	return parseResultTime(r.BuildCompletedTime)
+}
+
+func parseResultTime(value string) time.Time {
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}
+	}
+	return t
+}
+
+// PlanKey returns the key of the plan which produced the result
+func (r *Result) PlanKey() string {
+	key := r.BuildResultKey
+	if key == "" {
+		key = r.Key
+	}
+
+	planKey, _, _ := splitResultKey(key)
+	return planKey
+}
+
+// splitResultKey splits a result key such as "PROJ-PLAN-123" into the plan key and build number.
+// ok is false and the key is returned unchanged if it doesn't end with a build number.
+func splitResultKey(resultKey string) (planKey string, buildNumber int, ok bool) {
+	i := strings.LastIndex(resultKey, "-")
+	if i < 0 {
+		return resultKey, 0, false
+	}
+
+	buildNumber, err := strconv.Atoi(resultKey[i+1:])
+	if err != nil {
+		return resultKey, 0, false
+	}
+	return resultKey[:i], buildNumber, true
+}
+
+// LatestResults returns the latest build results of the given plan, newest first
+func (r *ResultService) LatestResults(planKey string, options *ResultListOptions) ([]*Result, *http.Response, error) {
+	if emptyStrings(planKey) {
+		return nil, nil, &simpleError{"Plan key cannot be empty"}
+	}
+
+	results, response, err := r.listResults(context.Background(), fmt.Sprintf("%s/%s.json", resultsBase, planKey), options)
+	if err != nil {
+		return nil, response, err
+	}
+	return results.ResultList, response, nil
+}
+
+// LatestSuccessful returns the newest successful result of the given plan or plan branch.
+// ErrNotFound is returned if the plan has no successful results.
+func (r *ResultService) LatestSuccessful(planKey string) (*Result, *http.Response, error) {
+	options := &ResultListOptions{
+		Page:       &Pagination{Limit: 1},
+		BuildState: SuccessfulBuildState,
+	}
+
+	results, response, err := r.LatestResults(planKey, options)
+	if err != nil {
+		return nil, response, err
+	}
+
+	if len(results) == 0 {
+		return nil, response, ErrNotFound
+	}
+	return results[0], response, nil
+}
+
+// ProjectResults returns the latest build results of every plan in the given project
+func (r *ResultService) ProjectResults(projectKey string, options *ResultListOptions) ([]*Result, *http.Response, error) {
+	if emptyStrings(projectKey) {
+		return nil, nil, &simpleError{"Project key cannot be empty"}
+	}
+
+	results, response, err := r.listResults(context.Background(), fmt.Sprintf("%s/%s.json", resultsBase, projectKey), options)
+	if err != nil {
+		return nil, response, err
+	}
+	return results.ResultList, response, nil
+}
+
+// BranchResults returns the latest build results of the given plan branch, newest first.
+// branchName is the name of the plan branch, see PlanBranchName.
+func (r *ResultService) BranchResults(planKey, branchName string, options *ResultListOptions) ([]*Result, *http.Response, error) {
+	if emptyStrings(planKey, branchName) {
+		return nil, nil, &simpleError{"Plan key and/or branch name cannot be empty"}
+	}
+
+	branch, response, err := (*PlanBranchService)(r).BranchInfo(planKey, url.PathEscape(branchName))
+	if err != nil {
+		return nil, response, err
+	}
+
+	if branch.PlanKey == nil || branch.Key == "" {
+		return nil, response, ErrNotFound
+	}
+	return r.LatestResults(branch.Key, options)
+}
+
+// SearchResultsByLabel returns the results of all plans which have the given label
+func (r *ResultService) SearchResultsByLabel(label string, options *ResultListOptions) ([]*Result, *http.Response, error) {
+	if emptyStrings(label) {
+		return nil, nil, &simpleError{"Label cannot be empty"}
+	}
+
+	labelled := ResultListOptions{}
+	if options != nil {
+		labelled = *options
+	}
+	labelled.Label = label
+
+	results, response, err := r.listResults(context.Background(), resultsBase+".json", &labelled)
+	if err != nil {
+		return nil, response, err
+	}
+	return results.ResultList, response, nil
+}
+
+// CurrentlyBuilding returns the results of all plans which are building right now.
+// All results are paged through unless options.Page is set.
+func (r *ResultService) CurrentlyBuilding(options *ResultListOptions) ([]*Result, *http.Response, error) {
+	building := ResultListOptions{}
+	if options != nil {
+		building = *options
+	}
+	building.IncludeAllStates = true
+	building.LifeCycleState = InProgressLifeCycleState
+
+	var results *Results
+	var response *http.Response
+	var err error
+	if building.Page != nil {
+		results, response, err = r.listResultsPage(context.Background(), resultsBase+".json", &building)
+	} else {
+		results, response, err = r.listAllResults(context.Background(), resultsBase+".json", &building)
+	}
+	if err != nil {
+		return nil, response, err
+	}
+	return results.ResultList, response, nil
+}
+
+// GetChainResult returns the given result, e.g. "PROJ-PLAN-123", with the full results
+// of all of its stages and jobs
+func (r *ResultService) GetChainResult(resultKey string) (*ChainResult, *http.Response, error) {
+	if emptyStrings(resultKey) {
+		return nil, nil, &simpleError{"Result key cannot be empty"}
+	}
+
+	request, err := r.client.NewRequest(http.MethodGet, fmt.Sprintf("%s/%s.json", resultsBase, resultKey), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	values := request.URL.Query()
+	values.Set("expand", "stages.stage.results.result")
+	request.URL.RawQuery = values.Encode()
+
+	result := Result{}
+	response, err := r.client.Do(request, &result)
+	if err != nil {
+		return nil, response, err
+	}
+
+	if response.StatusCode != 200 {
+		return nil, response, &simpleError{fmt.Sprintf("Getting result %s returned %s", resultKey, response.Status)}
+	}
+
+	return &ChainResult{&result}, response, nil
+}
+
+// ResultChanges returns the VCS changes, including the touched files, which were built
+// by the given result, e.g. "PROJ-PLAN-123"
+func (r *ResultService) ResultChanges(resultKey string) ([]Change, *http.Response, error) {
+	if emptyStrings(resultKey) {
+		return nil, nil, &simpleError{"Result key cannot be empty"}
+	}
+
+	request, err := r.client.NewRequest(http.MethodGet, fmt.Sprintf("%s/%s.json", resultsBase, resultKey), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	values := request.URL.Query()
+	values.Set("expand", "changes.change.files")
+	request.URL.RawQuery = values.Encode()
+
+	result := Result{}
+	response, err := r.client.Do(request, &result)
+	if err != nil {
+		return nil, response, err
+	}
+
+	if response.StatusCode != 200 {
+		return nil, response, &simpleError{fmt.Sprintf("Getting changes of %s returned %s", resultKey, response.Status)}
+	}
+
+	if result.ChangeSet.Set == nil {
+		return []Change{}, response, nil
+	}
+	return result.ChangeSet.Set, response, nil
+}
+
+// ResultMetadata returns the custom data of the given result, e.g. "PROJ-PLAN-123", keyed
+// by name. This includes the variables injected into the result during the build.
+//
+// Bamboo's REST API can't write custom data, builds have to set it with the
+// Inject Variables task or a plugin.
+func (r *ResultService) ResultMetadata(resultKey string) (map[string]string, *http.Response, error) {
+	if emptyStrings(resultKey) {
+		return nil, nil, &simpleError{"Result key cannot be empty"}
+	}
+
+	request, err := r.client.NewRequest(http.MethodGet, fmt.Sprintf("%s/%s.json", resultsBase, resultKey), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	values := request.URL.Query()
+	values.Set("expand", "metadata")
+	request.URL.RawQuery = values.Encode()
+
+	result := Result{}
+	response, err := r.client.Do(request, &result)
+	if err != nil {
+		return nil, response, err
+	}
+
+	if response.StatusCode != 200 {
+		return nil, response, &simpleError{fmt.Sprintf("Getting metadata of %s returned %s", resultKey, response.Status)}
+	}
+
+	metadata := map[string]string{}
+	if result.Metadata != nil {
+		for _, item := range result.Metadata.Items {
+			metadata[item.Key] = item.Value
+		}
+	}
+	return metadata, response, nil
+}
+
+// DeleteResult deletes the given result, e.g. "PROJ-PLAN-123", along with its logs and artifacts
+func (r *ResultService) DeleteResult(resultKey string) (*http.Response, error) {
+	if _, _, ok := splitResultKey(resultKey); !ok {
+		return nil, &simpleError{fmt.Sprintf("%q is not a result key", resultKey)}
+	}
+
+	request, err := r.client.NewRequest(http.MethodDelete, fmt.Sprintf("%s/%s", resultsBase, resultKey), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := r.client.Do(request, nil)
+	if err != nil {
+		return response, err
+	}
+
+	if response.StatusCode != 200 && response.StatusCode != 204 {
+		return response, &simpleError{fmt.Sprintf("Deleting result %s returned %s", resultKey, response.Status)}
+	}
+
+	return response, nil
+}
+
+// DeleteResultsRange deletes the results of the given plan from build number first up to and
+// including last, and returns the keys of the deleted results. Results which don't exist are skipped.
+func (r *ResultService) DeleteResultsRange(planKey string, first, last int) ([]string, *http.Response, error) {
+	if emptyStrings(planKey) || first < 1 || first > last {
+		return nil, nil, &simpleError{fmt.Sprintf("Can't delete results %d to %d of %q", first, last, planKey)}
+	}
+
+	deleted := []string{}
+	var response *http.Response
+	for n := first; n <= last; n++ {
+		resultKey := fmt.Sprintf("%s-%d", planKey, n)
+
+		var err error
+		response, err = r.DeleteResult(resultKey)
+		switch {
+		case response != nil && response.StatusCode == 404:
+			continue
+		case err != nil:
+			return deleted, response, err
+		}
+		deleted = append(deleted, resultKey)
+	}
+
+	return deleted, response, nil
+}
+
+// EachResult pages through the build results of the given plan, newest first, and calls fn
+// with each result until fn returns an error, there are no more results or ctx is cancelled.
+// The error returned by fn is passed on, options.Page sets the first page and the page size.
+func (r *ResultService) EachResult(ctx context.Context, planKey string, options *ResultListOptions, fn func(*Result) error) (*http.Response, error) {
+	if emptyStrings(planKey) || fn == nil {
+		return nil, &simpleError{"Plan key cannot be empty and fn cannot be nil"}
+	}
+
+	paged := ResultListOptions{}
+	if options != nil {
+		paged = *options
+	}
+	page := Pagination{Limit: defaultPageSize}
+	if paged.Page != nil {
+		page = *paged.Page
+	}
+	paged.Page = &page
+
+	for {
+		results, response, err := r.listResultsPage(ctx, fmt.Sprintf("%s/%s.json", resultsBase, planKey), &paged)
+		if err != nil {
+			return response, err
+		}
+
+		for _, result := range results.ResultList {
+			if err := fn(result); err != nil {
+				return response, err
+			}
+		}
+
+		// Filtering may drop results from the page, so continue after what Bamboo returned
+		if results.CollectionMetadata == nil || results.MaxResult == 0 {
+			return response, nil
+		}
+		page.Start = results.StartIndex + results.MaxResult
+		if !results.hasMore(page.Start) {
+			return response, nil
+		}
+	}
+}
+
+// listResults returns the results of the given result resource matching the options.
+// Filtering by start time pages through the results, as Bamboo can't apply it.
+func (r *ResultService) listResults(ctx context.Context, u string, options *ResultListOptions) (*Results, *http.Response, error) {
+	if !options.filtersByTime() {
+		return r.listResultsPage(ctx, u, options)
+	}
+	if options.Page != nil {
+		return nil, nil, &simpleError{"StartedAfter and StartedBefore can't be combined with Page"}
+	}
+	return r.listAllResults(ctx, u, options)
+}
+
+// listAllResults pages through the given result resource and returns the results matching the options.
+// Results are returned newest first, so paging stops at the first page reaching back before StartedAfter.
+func (r *ResultService) listAllResults(ctx context.Context, u string, options *ResultListOptions) (*Results, *http.Response, error) {
+	paged := *options
+	page := Pagination{Limit: defaultPageSize}
+	paged.Page = &page
+
+	matching := &Results{ResultList: []*Result{}}
+	for {
+		results, response, err := r.resultsPage(ctx, u, &paged)
+		if err != nil {
+			return nil, response, err
+		}
+
+		reachedStart := false
+		for _, result := range results.ResultList {
+			if options.matches(result) {
+				matching.ResultList = append(matching.ResultList, result)
+			}
+			started := result.StartedTime()
+			if !options.StartedAfter.IsZero() && !started.IsZero() && !started.After(options.StartedAfter) {
+				reachedStart = true
+			}
+		}
+		if reachedStart {
+			return matching, response, nil
+		}
+
+		// Filtering drops results from the page, so continue after what Bamboo returned
+		if results.CollectionMetadata == nil || results.MaxResult == 0 {
+			return matching, response, nil
+		}
+		page.Start = results.StartIndex + results.MaxResult
+		if !results.hasMore(page.Start) {
+			return matching, response, nil
+		}
+	}
+}
+
+// listResultsPage returns a single page of the given result resource, without the results which
+// don't match the filters Bamboo can't apply server side
+func (r *ResultService) listResultsPage(ctx context.Context, u string, options *ResultListOptions) (*Results, *http.Response, error) {
+	results, response, err := r.resultsPage(ctx, u, options)
+	if err != nil {
+		return nil, response, err
+	}
+
+	matching := []*Result{}
+	for _, r := range results.ResultList {
+		if options.matches(r) {
+			matching = append(matching, r)
+		}
+	}
+	results.ResultList = matching
+	return results, response, nil
+}
+
+// resultsPage returns a single page of the given result resource as Bamboo returned it
+func (r *ResultService) resultsPage(ctx context.Context, u string, options *ResultListOptions) (*Results, *http.Response, error) {
+	request, err := r.client.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	request = request.WithContext(ctx)
+
+	values := request.URL.Query()
+	options.setQuery(values)
+	request.URL.RawQuery = values.Encode()
+
+	result := ResultsResponse{}
+	response, err := r.client.Do(request, &result)
+	if err != nil {
+		return nil, response, err
+	}
+
+	if response.StatusCode != 200 {
+		return nil, response, &simpleError{fmt.Sprintf("API returned unexpected status code %d", response.StatusCode)}
+	}
+
+	if result.Results == nil {
+		return &Results{}, response, nil
+	}
+	return result.Results, response, nil
+}
+
+// ResultStages returns the stages and job results of the given chain result, see GetChainResult
+func (r *ResultService) ResultStages(resultKey string) ([]*StageResult, *http.Response, error) {
+	chain, response, err := r.GetChainResult(resultKey)
+	if err != nil {
+		return nil, response, err
+	}
+
+	if chain.Stages == nil {
+		return []*StageResult{}, response, nil
+	}
+	return chain.Stages.StageList, response, nil
+}
+
+// ContinueBuild resumes the given chain result, e.g. "PROJ-PLAN-123", from its failed or manual
+// stage up to and including the given stage. An empty stage only runs the next stage, or
+// reruns the failed jobs of a failed result.
+func (r *ResultService) ContinueBuild(resultKey, stage string) (*QueuedBuild, *http.Response, error) {
+	if _, _, ok := splitResultKey(resultKey); !ok {
+		return nil, nil, &simpleError{fmt.Sprintf("%q is not a result key", resultKey)}
+	}
+
+	request, err := r.client.NewRequest(http.MethodPut, fmt.Sprintf("queue/%s.json", resultKey), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if stage != "" {
+		values := request.URL.Query()
+		values.Set("stage", stage)
+		request.URL.RawQuery = values.Encode()
+	}
+
+	queued := QueuedBuild{}
+	response, err := r.client.Do(request, &queued)
+	if err != nil {
+		return nil, response, err
+	}
+
+	if response.StatusCode != 200 {
+		return nil, response, &simpleError{fmt.Sprintf("Continuing build %s returned %s", resultKey, response.Status)}
+	}
+
+	return &queued, response, nil
+}
+
+// RestartFailedJobs reruns only the failed jobs of the given failed chain result, e.g. "PROJ-PLAN-123"
+func (r *ResultService) RestartFailedJobs(resultKey string) (*QueuedBuild, *http.Response, error) {
+	result, response, err := r.GetResultByKey(resultKey)
+	if err != nil {
+		return nil, response, err
+	}
+
+	if result.BuildState != FailedBuildState {
+		return nil, response, &simpleError{fmt.Sprintf("Build %s has no failed jobs to restart, it is %s", resultKey, result.BuildState)}
+	}
+
+	// Requeueing a failed result makes Bamboo rerun the failed jobs of its failed stage
+	return r.ContinueBuild(resultKey, "")
+}
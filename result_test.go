@@ -1,11 +1,18 @@
 package bamboo_test
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/stretchr/testify/assert"
 	bamboo "github.com/sukhyun/go-bamboo"
 )
 
@@ -13,7 +20,7 @@ func TestLatestResult(t *testing.T) {
 	ts := httptest.NewServer(http.HandlerFunc(latestResultStub))
 	defer ts.Close()
 
-	client := bamboo.NewSimpleClient(nil, "", "")
+	client := bamboo.NewSimpleClient(nil, "", "", "")
 	client.SetURL(ts.URL)
 
 	_, resp, err := client.Results.LatestResult("CORE-TEST")
@@ -40,7 +47,7 @@ func TestNumberedResult(t *testing.T) {
 	ts := httptest.NewServer(http.HandlerFunc(numberedResultStub))
 	defer ts.Close()
 
-	client := bamboo.NewSimpleClient(nil, "", "")
+	client := bamboo.NewSimpleClient(nil, "", "", "")
 	client.SetURL(ts.URL)
 
 	_, resp, err := client.Results.NumberedResult("CORE-TEST-1")
@@ -62,3 +69,656 @@ func numberedResultStub(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusBadRequest)
 	}
 }
+
+func TestResultPlanKey(t *testing.T) {
+	var testCases = []struct {
+		result   bamboo.Result
+		expected string
+	}{
+		{bamboo.Result{BuildResultKey: "CORE-TEST-12"}, "CORE-TEST"},
+		{bamboo.Result{Key: "CORE-TEST-JOB1-3"}, "CORE-TEST-JOB1"},
+		{bamboo.Result{Key: "CORE-TEST"}, "CORE-TEST"},
+	}
+
+	for _, c := range testCases {
+		if key := c.result.PlanKey(); key != c.expected {
+			t.Errorf("Plan key %s does not equal expected key %s", key, c.expected)
+		}
+	}
+}
+
+func TestLatestResults(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(latestResultsStub))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	options := &bamboo.ResultListOptions{Page: &bamboo.Pagination{Limit: 2}}
+	results, _, err := client.Results.LatestResults("CORE-TEST", options)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(results) != 2 || results[0].BuildNumber != 2 || results[0].BuildState != bamboo.SuccessfulBuildState {
+		t.Errorf("Unexpected results %+v", results)
+	}
+}
+
+func latestResultsStub(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/rest/api/latest/result/CORE-TEST.json" || r.URL.Query().Get("max-results") != "2" {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	resp := bamboo.ResultsResponse{Results: &bamboo.Results{ResultList: []*bamboo.Result{
+		&bamboo.Result{BuildNumber: 2, BuildState: bamboo.SuccessfulBuildState, LifeCycleState: "Finished"},
+		&bamboo.Result{BuildNumber: 1, BuildState: bamboo.FailedBuildState, LifeCycleState: "Finished"},
+	}}}
+
+	bytes, err := json.Marshal(resp)
+	if err != nil {
+		panic(err)
+	}
+
+	w.Write(bytes)
+}
+
+func TestGetResult(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(getResultStub))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	result, _, err := client.Results.GetResult("CORE-TEST", 7)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result.VcsRevisionKey != "abc123" || result.Duration() != 90*time.Second {
+		t.Errorf("Unexpected result %+v", result)
+	}
+
+	if _, _, err = client.Results.GetResultByKey("CORE-TEST-7"); err != nil {
+		t.Error(err)
+	}
+}
+
+func getResultStub(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/rest/api/latest/result/CORE-TEST-7" {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	resp := bamboo.Result{
+		BuildResultKey: "CORE-TEST-7",
+		BuildNumber:    7,
+		BuildState:     bamboo.SuccessfulBuildState,
+		BuildReason:    "Manual run by admin",
+		BuildDuration:  90000,
+		VcsRevisionKey: "abc123",
+	}
+
+	bytes, err := json.Marshal(resp)
+	if err != nil {
+		panic(err)
+	}
+
+	w.Write(bytes)
+}
+
+func TestLatestResultsFilters(t *testing.T) {
+	pages := map[string]bool{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		pages[r.URL.Query().Get("start-index")] = true
+		filteredResultsStub(w, r)
+	}))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	options := &bamboo.ResultListOptions{
+		BuildState:       bamboo.FailedBuildState,
+		Label:            "release",
+		IssueKey:         "CORE-1",
+		IncludeAllStates: true,
+		StartedAfter:     time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+	results, _, err := client.Results.LatestResults("CORE-TEST", options)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(results) != 3 || results[0].BuildNumber != 6 || results[2].BuildNumber != 4 {
+		t.Errorf("Unexpected results %+v", results)
+	}
+
+	// Result 3 started before StartedAfter, so the page after it can't match
+	if len(pages) != 2 || pages["4"] {
+		t.Errorf("Unexpected pages requested %v", pages)
+	}
+
+	// A single page would come back short of results after filtering
+	options.Page = &bamboo.Pagination{Limit: 2}
+	if _, _, err := client.Results.LatestResults("CORE-TEST", options); err == nil {
+		t.Error("Expected an error when combining Page with start time filters")
+	}
+}
+
+func filteredResultsStub(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	if q.Get("buildstate") != bamboo.FailedBuildState || q.Get("label") != "release" ||
+		q.Get("issueKey") != "CORE-1" || q.Get("includeAllStates") != "true" {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	// Served newest first, two results per page
+	all := []*bamboo.Result{
+		&bamboo.Result{BuildNumber: 6, BuildStartedTime: "2020-01-03T10:00:00.000+01:00"},
+		&bamboo.Result{BuildNumber: 5, BuildStartedTime: "2020-01-02T10:00:00.000+01:00"},
+		&bamboo.Result{BuildNumber: 4, BuildStartedTime: "2020-01-01T12:00:00.000+01:00"},
+		&bamboo.Result{BuildNumber: 3, BuildStartedTime: "2019-12-31T10:00:00.000+01:00"},
+		&bamboo.Result{BuildNumber: 2, BuildStartedTime: "2019-12-30T10:00:00.000+01:00"},
+		&bamboo.Result{BuildNumber: 1, BuildStartedTime: "2019-12-29T10:00:00.000+01:00"},
+	}
+	start, _ := strconv.Atoi(q.Get("start-index"))
+	resp := bamboo.ResultsResponse{Results: &bamboo.Results{
+		CollectionMetadata: &bamboo.CollectionMetadata{Size: len(all), StartIndex: start, MaxResult: 2},
+		ResultList:         all[start : start+2],
+	}}
+
+	bytes, err := json.Marshal(resp)
+	if err != nil {
+		panic(err)
+	}
+
+	w.Write(bytes)
+}
+
+func TestResultStages(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(resultStagesStub))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	stages, _, err := client.Results.ResultStages("CORE-TEST-7")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(stages) != 2 || stages[1].Results.ResultList[0].BuildState != bamboo.FailedBuildState {
+		t.Errorf("Unexpected stages %+v", stages)
+	}
+
+	options := &bamboo.ResultListOptions{Expand: "results.result.labels", ExpandStages: true}
+	results, _, err := client.Results.LatestResults("CORE-TEST", options)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(results) != 1 || len(results[0].Stages.StageList) != 2 {
+		t.Errorf("Unexpected results %+v", results)
+	}
+}
+
+func resultStagesStub(w http.ResponseWriter, r *http.Request) {
+	stages := &bamboo.StageResults{StageList: []*bamboo.StageResult{
+		&bamboo.StageResult{Name: "Build", State: bamboo.SuccessfulBuildState, Results: &bamboo.Results{ResultList: []*bamboo.Result{
+			&bamboo.Result{Key: "CORE-TEST-JOB1-7", BuildState: bamboo.SuccessfulBuildState},
+		}}},
+		&bamboo.StageResult{Name: "Test", State: bamboo.FailedBuildState, Results: &bamboo.Results{ResultList: []*bamboo.Result{
+			&bamboo.Result{Key: "CORE-TEST-JOB2-7", BuildState: bamboo.FailedBuildState},
+		}}},
+	}}
+
+	var resp interface{}
+	switch expand := r.URL.Query().Get("expand"); {
+	case r.URL.Path == "/rest/api/latest/result/CORE-TEST-7.json" && expand == "stages.stage.results.result":
+		resp = bamboo.Result{Key: "CORE-TEST-7", Stages: stages}
+	case r.URL.Path == "/rest/api/latest/result/CORE-TEST.json" && expand == "results.result.labels,results.result.stages.stage.results":
+		resp = bamboo.ResultsResponse{Results: &bamboo.Results{ResultList: []*bamboo.Result{
+			&bamboo.Result{Key: "CORE-TEST-7", Stages: stages},
+		}}}
+	default:
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	bytes, err := json.Marshal(resp)
+	if err != nil {
+		panic(err)
+	}
+
+	w.Write(bytes)
+}
+
+func TestCurrentlyBuilding(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(currentlyBuildingStub))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	results, _, err := client.Results.CurrentlyBuilding(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(results) != 2 || results[0].Key != "CORE-TEST-9" || results[1].Key != "CORE-OTHER-4" {
+		t.Errorf("Unexpected results %+v", results)
+	}
+
+	results, _, err = client.Results.CurrentlyBuilding(&bamboo.ResultListOptions{Page: &bamboo.Pagination{Limit: 2}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(results) != 1 || results[0].Key != "CORE-TEST-9" {
+		t.Errorf("Unexpected results %+v", results)
+	}
+}
+
+func currentlyBuildingStub(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	if r.URL.Path != "/rest/api/latest/result.json" || q.Get("includeAllStates") != "true" || q.Get("lifeCycleState") != bamboo.InProgressLifeCycleState {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	// Servers which ignore lifeCycleState also return finished results, two per page
+	resp := bamboo.ResultsResponse{Results: &bamboo.Results{
+		CollectionMetadata: &bamboo.CollectionMetadata{Size: 4, MaxResult: 2},
+		ResultList: []*bamboo.Result{
+			&bamboo.Result{Key: "CORE-TEST-9", LifeCycleState: bamboo.InProgressLifeCycleState},
+			&bamboo.Result{Key: "CORE-TEST-8", LifeCycleState: bamboo.FinishedLifeCycleState},
+		},
+	}}
+	if q.Get("start-index") == "2" {
+		resp.Results.StartIndex = 2
+		resp.Results.ResultList = []*bamboo.Result{
+			&bamboo.Result{Key: "CORE-OTHER-5", LifeCycleState: bamboo.FinishedLifeCycleState},
+			&bamboo.Result{Key: "CORE-OTHER-4", LifeCycleState: bamboo.InProgressLifeCycleState},
+		}
+	}
+	bytes, err := json.Marshal(resp)
+	if err != nil {
+		panic(err)
+	}
+
+	w.Write(bytes)
+}
+
+func TestGetChainResult(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(chainResultStub))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	chain, _, err := client.Results.GetChainResult("CORE-TEST-7")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if chain.Key != "CORE-TEST-7" || chain.CompletedTime().Sub(chain.StartedTime()) != 90*time.Second {
+		t.Errorf("Unexpected chain result %+v", chain.Result)
+	}
+
+	jobs := chain.Jobs()
+	if len(jobs) != 2 || jobs[0].Agent == nil || jobs[0].Agent.Name != "linux-agent-1" {
+		t.Errorf("Unexpected jobs %+v", jobs)
+	}
+
+	failed := chain.FailedJobs()
+	if len(failed) != 1 || failed[0].Key != "CORE-TEST-JOB2-7" || !failed[0].Restartable {
+		t.Errorf("Unexpected failed jobs %+v", failed)
+	}
+
+	// The stages keep their plain job results
+	stage := chain.Stages.StageList[0]
+	if stage.Results == nil || len(stage.Results.ResultList) != 1 || stage.Results.ResultList[0].Key != "CORE-TEST-JOB1-7" {
+		t.Errorf("Unexpected stage %+v", stage)
+	}
+}
+
+func chainResultStub(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/rest/api/latest/result/CORE-TEST-7.json" || r.URL.Query().Get("expand") != "stages.stage.results.result" {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	w.Write([]byte(`{"key":"CORE-TEST-7","buildState":"Failed","lifeCycleState":"Finished",
+		"buildStartedTime":"2020-01-02T10:00:00.000+01:00","buildCompletedTime":"2020-01-02T10:01:30.000+01:00",
+		"stages":{"size":2,"stage":[
+			{"name":"Build","state":"Successful","results":{"size":1,"result":[
+				{"key":"CORE-TEST-JOB1-7","buildState":"Successful","agent":{"id":131073,"name":"linux-agent-1","type":"REMOTE"}}]}},
+			{"name":"Test","state":"Failed","results":{"size":1,"result":[
+				{"key":"CORE-TEST-JOB2-7","buildState":"Failed","restartable":true}]}}]}}`))
+}
+
+func TestBranchResults(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(branchResultsStub))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	results, _, err := client.Results.BranchResults("CORE-TEST", "feature-login", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(results) != 1 || results[0].Key != "CORE-TEST3-4" {
+		t.Errorf("Unexpected results %+v", results)
+	}
+}
+
+func branchResultsStub(w http.ResponseWriter, r *http.Request) {
+	var resp interface{}
+	switch r.URL.Path {
+	case "/rest/api/latest/plan/CORE-TEST/branch/feature-login":
+		resp = bamboo.Branch{ShortName: "feature-login", PlanKey: &bamboo.PlanKey{Key: "CORE-TEST3"}}
+	case "/rest/api/latest/result/CORE-TEST3.json":
+		resp = bamboo.ResultsResponse{Results: &bamboo.Results{ResultList: []*bamboo.Result{&bamboo.Result{Key: "CORE-TEST3-4"}}}}
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	bytes, err := json.Marshal(resp)
+	if err != nil {
+		panic(err)
+	}
+
+	w.Write(bytes)
+}
+
+func TestProjectResults(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(projectResultsStub))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	options := &bamboo.ResultListOptions{BuildState: bamboo.FailedBuildState}
+	results, _, err := client.Results.ProjectResults("CORE", options)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(results) != 2 || results[1].PlanKey() != "CORE-OTHER" {
+		t.Errorf("Unexpected results %+v", results)
+	}
+
+	if _, _, err := client.Results.ProjectResults("", nil); err == nil {
+		t.Error("Expected an error for an empty project key")
+	}
+}
+
+func projectResultsStub(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/rest/api/latest/result/CORE.json" || r.URL.Query().Get("buildstate") != bamboo.FailedBuildState {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	resp := bamboo.ResultsResponse{Results: &bamboo.Results{ResultList: []*bamboo.Result{
+		&bamboo.Result{Key: "CORE-TEST-7", BuildState: bamboo.FailedBuildState},
+		&bamboo.Result{Key: "CORE-OTHER-2", BuildState: bamboo.FailedBuildState},
+	}}}
+	bytes, err := json.Marshal(resp)
+	if err != nil {
+		panic(err)
+	}
+
+	w.Write(bytes)
+}
+
+func TestResultChanges(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(resultChangesStub))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	changes, _, err := client.Results.ResultChanges("CORE-TEST-7")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(changes) != 1 || changes[0].Comment != "Fix login" || len(changes[0].Files.FileList) != 2 {
+		t.Errorf("Unexpected changes %+v", changes)
+	}
+}
+
+func resultChangesStub(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/rest/api/latest/result/CORE-TEST-7.json" || r.URL.Query().Get("expand") != "changes.change.files" {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	w.Write([]byte(`{"key":"CORE-TEST-7","changes":{"size":1,"change":[
+		{"author":"jdoe","userName":"jdoe","fullName":"Jane Doe","changesetId":"4b825dc6","comment":"Fix login",
+		"files":{"size":2,"file":[{"name":"login.go","revision":"4b825dc6"},{"name":"login_test.go","revision":"4b825dc6"}]}}]}}`))
+}
+
+func TestResultMetadata(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(resultMetadataStub))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	metadata, _, err := client.Results.ResultMetadata("CORE-TEST-7")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(metadata) != 2 || metadata["inject.artifact.version"] != "1.4.2" {
+		t.Errorf("Unexpected metadata %+v", metadata)
+	}
+}
+
+func resultMetadataStub(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/rest/api/latest/result/CORE-TEST-7.json" || r.URL.Query().Get("expand") != "metadata" {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	resp := bamboo.Result{Key: "CORE-TEST-7", Metadata: &bamboo.Metadata{Items: []*bamboo.MetadataItem{
+		&bamboo.MetadataItem{Key: "inject.artifact.version", Value: "1.4.2"},
+		&bamboo.MetadataItem{Key: "ManualBuildTriggerReason.userName", Value: "jdoe"},
+	}}}
+	bytes, err := json.Marshal(resp)
+	if err != nil {
+		panic(err)
+	}
+
+	w.Write(bytes)
+}
+
+func TestLatestSuccessful(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(latestSuccessfulStub))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	result, _, err := client.Results.LatestSuccessful("CORE-TEST")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result.Key != "CORE-TEST-5" {
+		t.Errorf("Unexpected result %+v", result)
+	}
+
+	if _, _, err := client.Results.LatestSuccessful("CORE-NEW"); err != bamboo.ErrNotFound {
+		t.Errorf("Expected ErrNotFound, got %v", err)
+	}
+}
+
+func latestSuccessfulStub(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	if q.Get("buildstate") != bamboo.SuccessfulBuildState || q.Get("max-results") != "1" {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	resp := bamboo.ResultsResponse{Results: &bamboo.Results{}}
+	switch r.URL.Path {
+	case "/rest/api/latest/result/CORE-TEST.json":
+		resp.Results.ResultList = []*bamboo.Result{&bamboo.Result{Key: "CORE-TEST-5", BuildState: bamboo.SuccessfulBuildState}}
+	case "/rest/api/latest/result/CORE-NEW.json":
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	bytes, err := json.Marshal(resp)
+	if err != nil {
+		panic(err)
+	}
+
+	w.Write(bytes)
+}
+
+func TestDeleteResultsRange(t *testing.T) {
+	existing := map[string]bool{"CORE-TEST-2": true, "CORE-TEST-3": true, "CORE-TEST-4": true}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := strings.TrimPrefix(r.URL.Path, "/rest/api/latest/result/")
+		switch {
+		case r.Method != http.MethodDelete:
+			w.WriteHeader(http.StatusBadRequest)
+		case !existing[key]:
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			delete(existing, key)
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	if _, err := client.Results.DeleteResult("CORE-TEST-3"); err != nil {
+		t.Error(err)
+	}
+
+	deleted, _, err := client.Results.DeleteResultsRange("CORE-TEST", 2, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(deleted) != 2 || deleted[0] != "CORE-TEST-2" || deleted[1] != "CORE-TEST-4" || len(existing) != 0 {
+		t.Errorf("Unexpected deleted results %v", deleted)
+	}
+
+	if _, _, err := client.Results.DeleteResultsRange("CORE-TEST", 4, 2); err == nil {
+		t.Error("Expected an error for an empty range")
+	}
+}
+
+func TestEachResult(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(eachResultStub))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	keys := []string{}
+	options := &bamboo.ResultListOptions{Page: &bamboo.Pagination{Limit: 2}}
+	_, err := client.Results.EachResult(context.Background(), "CORE-TEST", options, func(result *bamboo.Result) error {
+		keys = append(keys, result.Key)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if strings.Join(keys, ",") != "CORE-TEST-5,CORE-TEST-4,CORE-TEST-3,CORE-TEST-2,CORE-TEST-1" {
+		t.Errorf("Unexpected results %v", keys)
+	}
+
+	stop := errors.New("stop")
+	count := 0
+	_, err = client.Results.EachResult(context.Background(), "CORE-TEST", options, func(result *bamboo.Result) error {
+		if count++; count == 3 {
+			return stop
+		}
+		return nil
+	})
+	if err != stop || count != 3 {
+		t.Errorf("Expected iteration to stop after 3 results, got %d and %v", count, err)
+	}
+}
+
+func eachResultStub(w http.ResponseWriter, r *http.Request) {
+	start, _ := strconv.Atoi(r.URL.Query().Get("start-index"))
+	if r.URL.Path != "/rest/api/latest/result/CORE-TEST.json" || r.URL.Query().Get("max-results") != "2" {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	results := &bamboo.Results{CollectionMetadata: &bamboo.CollectionMetadata{Size: 5, StartIndex: start}}
+	for n := 5 - start; n > 0 && len(results.ResultList) < 2; n-- {
+		results.ResultList = append(results.ResultList, &bamboo.Result{Key: fmt.Sprintf("CORE-TEST-%d", n)})
+	}
+	results.MaxResult = len(results.ResultList)
+
+	bytes, err := json.Marshal(bamboo.ResultsResponse{Results: results})
+	if err != nil {
+		panic(err)
+	}
+
+	w.Write(bytes)
+}
+
+func TestContinueBuild(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/rest/api/latest/queue/CORE-TEST-8.json" || r.URL.Query().Get("stage") != "Release" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Write([]byte(`{"planKey":"CORE-TEST","buildNumber":8,"buildResultKey":"CORE-TEST-8"}`))
+	}))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	queued, _, err := client.Results.ContinueBuild("CORE-TEST-8", "Release")
+	assert.NoError(t, err)
+	assert.Equal(t, "CORE-TEST-8", queued.BuildResultKey)
+}
+
+func TestRestartFailedJobs(t *testing.T) {
+	state := bamboo.FailedBuildState
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/rest/api/latest/result/CORE-TEST-8":
+			bytes, _ := json.Marshal(bamboo.Result{Key: "CORE-TEST-8", BuildState: state})
+			w.Write(bytes)
+		case r.Method == http.MethodPut && r.URL.Path == "/rest/api/latest/queue/CORE-TEST-8.json":
+			w.Write([]byte(`{"planKey":"CORE-TEST","buildNumber":8,"buildResultKey":"CORE-TEST-8"}`))
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	queued, _, err := client.Results.RestartFailedJobs("CORE-TEST-8")
+	assert.NoError(t, err)
+	assert.Equal(t, "CORE-TEST-8", queued.BuildResultKey)
+
+	state = bamboo.SuccessfulBuildState
+	_, _, err = client.Results.RestartFailedJobs("CORE-TEST-8")
+	assert.Error(t, err)
+}
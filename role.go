@@ -18,6 +18,10 @@ type roleProjectPlanResponce struct {
 
 // RolePermissionsList returns the list of permissions for the roles on the given entity in the given resource
 func (p *Permissions) RolePermissionsList(opts PermissionsOpts) ([]Role, *http.Response, error) {
+	if !knownResources[opts.Resource] {
+		return nil, nil, &simpleError{fmt.Sprintf("Unknown resource %s", opts.Resource)}
+	}
+
 	request, err := p.client.NewRequest(http.MethodGet, rolePermissionsListURL(opts.Resource, opts.Key), nil)
 	if err != nil {
 		return nil, nil, err
@@ -40,6 +44,10 @@ func (p *Permissions) RolePermissionsList(opts PermissionsOpts) ([]Role, *http.R
 
 // SetLoggedInUsersPermissions sets the logged in users role's permissions for the given project's plans to the passed in permissions
 func (p *Permissions) SetLoggedInUsersPermissions(permissions []string, opts PermissionsOpts) (*http.Response, error) {
+	if !knownResources[opts.Resource] {
+		return nil, &simpleError{fmt.Sprintf("Unknown resource %s", opts.Resource)}
+	}
+
 	request, err := p.client.NewRequest(http.MethodPut, loggedInRolePermissionsURL(opts.Resource, opts.Key), permissions)
 	if err != nil {
 		return nil, err
@@ -60,11 +68,15 @@ func (p *Permissions) SetLoggedInUsersPermissions(permissions []string, opts Per
 	default:
 		return response, &simpleError{fmt.Sprintf("Server responded with unexpected return code %d", response.StatusCode)}
 	}
-	return nil, nil
+	return response, nil
 }
 
 // RemoveLoggedInUsersPermissions removes the given permissions from the logged in users role's permissions for the given project's plans
 func (p *Permissions) RemoveLoggedInUsersPermissions(permissions []string, opts PermissionsOpts) (*http.Response, error) {
+	if !knownResources[opts.Resource] {
+		return nil, &simpleError{fmt.Sprintf("Unknown resource %s", opts.Resource)}
+	}
+
 	request, err := p.client.NewRequest(http.MethodDelete, loggedInRolePermissionsURL(opts.Resource, opts.Key), permissions)
 	if err != nil {
 		return nil, err
@@ -85,11 +97,15 @@ func (p *Permissions) RemoveLoggedInUsersPermissions(permissions []string, opts
 	default:
 		return response, &simpleError{fmt.Sprintf("Server responded with unexpected return code %d", response.StatusCode)}
 	}
-	return nil, nil
+	return response, nil
 }
 
 // SetAnonymousReadPermission allows anonymous users to view plans
 func (p *Permissions) SetAnonymousReadPermission(opts PermissionsOpts) (*http.Response, error) {
+	if !knownResources[opts.Resource] {
+		return nil, &simpleError{fmt.Sprintf("Unknown resource %s", opts.Resource)}
+	}
+
 	request, err := p.client.NewRequest(http.MethodPut, anonymousRolePermissionsURL(opts.Resource, opts.Key), []string{ReadPermission})
 	if err != nil {
 		return nil, err
@@ -110,11 +126,15 @@ func (p *Permissions) SetAnonymousReadPermission(opts PermissionsOpts) (*http.Re
 	default:
 		return response, &simpleError{fmt.Sprintf("Server responded with unexpected return code %d", response.StatusCode)}
 	}
-	return nil, nil
+	return response, nil
 }
 
 // RemoveAnonymousReadPermission removes the ability for anonymous users to view plans
 func (p *Permissions) RemoveAnonymousReadPermission(opts PermissionsOpts) (*http.Response, error) {
+	if !knownResources[opts.Resource] {
+		return nil, &simpleError{fmt.Sprintf("Unknown resource %s", opts.Resource)}
+	}
+
 	request, err := p.client.NewRequest(http.MethodDelete, anonymousRolePermissionsURL(opts.Resource, opts.Key), []string{ReadPermission})
 	if err != nil {
 		return nil, err
@@ -137,5 +157,5 @@ func (p *Permissions) RemoveAnonymousReadPermission(opts PermissionsOpts) (*http
 	default:
 		return response, &simpleError{fmt.Sprintf("Server responded with unexpected return code %d", response.StatusCode)}
 	}
-	return nil, nil
+	return response, nil
 }
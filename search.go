@@ -0,0 +1,141 @@
+package bamboo
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// PlanSearchOptions specifies the optional parameters to the SearchPlans method
+// - IncludeBranches: Include plan branches in the results
+// - Fuzzy:           Match the term anywhere in the plan name rather than only its prefix
+type PlanSearchOptions struct {
+	Pagination
+	IncludeBranches bool
+	Fuzzy           bool
+}
+
+// PlanSearchResponse holds the results of a plan search
+type PlanSearchResponse struct {
+	*CollectionMetadata
+	SearchResults []*PlanSearchResult `json:"searchResults"`
+}
+
+// PlanSearchResult is a single result of a plan search
+type PlanSearchResult struct {
+	ID           string            `json:"id"`
+	Type         string            `json:"type"`
+	SearchEntity *PlanSearchEntity `json:"searchEntity"`
+}
+
+// PlanSearchEntity holds the plan information of a search result
+type PlanSearchEntity struct {
+	ID          string `json:"id"`
+	Key         string `json:"key"`
+	ProjectName string `json:"projectName"`
+	PlanName    string `json:"planName"`
+	BranchName  string `json:"branchName,omitempty"`
+	Description string `json:"description,omitempty"`
+	Type        string `json:"type"`
+}
+
+func setSearchQuery(values url.Values, term string, page Pagination) {
+	values.Set("searchTerm", term)
+	page.setIndexQuery(values)
+}
+
+// SearchPlans returns the plans whose name matches the given search term
+func (p *PlanService) SearchPlans(term string, options *PlanSearchOptions) ([]*PlanSearchEntity, *http.Response, error) {
+	if emptyStrings(term) {
+		return nil, nil, &simpleError{"Search term cannot be empty"}
+	}
+
+	request, err := p.client.NewRequest(http.MethodGet, "search/plans.json", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	values := request.URL.Query()
+	if options != nil {
+		setSearchQuery(values, term, options.Pagination)
+		if options.IncludeBranches {
+			values.Set("includeBranches", "true")
+		}
+		if options.Fuzzy {
+			values.Set("fuzzy", "true")
+		}
+	} else {
+		setSearchQuery(values, term, Pagination{})
+	}
+	request.URL.RawQuery = values.Encode()
+
+	searchResp := PlanSearchResponse{}
+	response, err := p.client.Do(request, &searchResp)
+	if err != nil {
+		return nil, response, err
+	}
+
+	if response.StatusCode != 200 {
+		return nil, response, &simpleError{fmt.Sprintf("Searching plans for %q returned %s", term, response.Status)}
+	}
+
+	plans := make([]*PlanSearchEntity, len(searchResp.SearchResults))
+	for i, result := range searchResp.SearchResults {
+		plans[i] = result.SearchEntity
+	}
+	return plans, response, nil
+}
+
+// ProjectSearchResponse holds the results of a project search
+type ProjectSearchResponse struct {
+	*CollectionMetadata
+	SearchResults []*ProjectSearchResult `json:"searchResults"`
+}
+
+// ProjectSearchResult is a single result of a project search
+type ProjectSearchResult struct {
+	ID           string               `json:"id"`
+	Type         string               `json:"type"`
+	SearchEntity *ProjectSearchEntity `json:"searchEntity"`
+}
+
+// ProjectSearchEntity holds the project information of a search result
+type ProjectSearchEntity struct {
+	ID          string `json:"id"`
+	Key         string `json:"key"`
+	ProjectName string `json:"projectName"`
+	Description string `json:"description,omitempty"`
+	Type        string `json:"type"`
+}
+
+// SearchProjects returns the projects whose name matches the given search term
+func (p *ProjectService) SearchProjects(term string) ([]*ProjectSearchEntity, *http.Response, error) {
+	if emptyStrings(term) {
+		return nil, nil, &simpleError{"Search term cannot be empty"}
+	}
+
+	request, err := p.client.NewRequest(http.MethodGet, "search/projects.json", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	values := request.URL.Query()
+	setSearchQuery(values, term, Pagination{})
+	request.URL.RawQuery = values.Encode()
+
+	searchResp := ProjectSearchResponse{}
+	response, err := p.client.Do(request, &searchResp)
+	if err != nil {
+		return nil, response, err
+	}
+
+	if response.StatusCode != 200 {
+		return nil, response, &simpleError{fmt.Sprintf("Searching projects for %q returned %s", term, response.Status)}
+	}
+
+	projects := make([]*ProjectSearchEntity, len(searchResp.SearchResults))
+	for i, result := range searchResp.SearchResults {
+		projects[i] = result.SearchEntity
+	}
+	return projects, response, nil
+}
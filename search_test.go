@@ -0,0 +1,93 @@
+package bamboo_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	bamboo "github.com/sukhyun/go-bamboo"
+)
+
+func TestSearchPlans(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(searchPlansStub))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	options := &bamboo.PlanSearchOptions{Pagination: bamboo.Pagination{Limit: 10}, IncludeBranches: true}
+	plans, response, err := client.Plans.SearchPlans("core", options)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	assert.Equal(t, 1, len(plans))
+	assert.Equal(t, "CORE-TEST", plans[0].Key)
+
+	_, _, err = client.Plans.SearchPlans("", nil)
+	assert.Error(t, err)
+}
+
+func searchPlansStub(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	if r.URL.Path != "/rest/api/latest/search/plans.json" || q.Get("searchTerm") != "core" ||
+		q.Get("max-results") != "10" || q.Get("includeBranches") != "true" {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	resp := bamboo.PlanSearchResponse{
+		SearchResults: []*bamboo.PlanSearchResult{
+			&bamboo.PlanSearchResult{
+				ID:           "CORE-TEST",
+				Type:         "plan",
+				SearchEntity: &bamboo.PlanSearchEntity{ID: "CORE-TEST", Key: "CORE-TEST", PlanName: "Test"},
+			},
+		},
+	}
+
+	bytes, err := json.Marshal(resp)
+	if err != nil {
+		panic(err)
+	}
+
+	w.Write(bytes)
+}
+
+func TestSearchProjects(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(searchProjectsStub))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	projects, response, err := client.Projects.SearchProjects("co")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	assert.Equal(t, 1, len(projects))
+	assert.Equal(t, "CORE", projects[0].Key)
+}
+
+func searchProjectsStub(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/rest/api/latest/search/projects.json" || r.URL.Query().Get("searchTerm") != "co" {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	resp := bamboo.ProjectSearchResponse{
+		SearchResults: []*bamboo.ProjectSearchResult{
+			&bamboo.ProjectSearchResult{
+				ID:           "CORE",
+				Type:         "project",
+				SearchEntity: &bamboo.ProjectSearchEntity{ID: "CORE", Key: "CORE", ProjectName: "Core"},
+			},
+		},
+	}
+
+	bytes, err := json.Marshal(resp)
+	if err != nil {
+		panic(err)
+	}
+
+	w.Write(bytes)
+}
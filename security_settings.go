@@ -0,0 +1,62 @@
+package bamboo
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// SecuritySettings holds the global security settings of the server
+// - AnonymousAccessEnabled: True if users can view the server without logging in
+// - SignupEnabled:          True if anyone can create an account
+// - SignupCaptchaEnabled:   True if a CAPTCHA must be solved to create an account
+// - LoginCaptchaThreshold:  Number of failed logins after which a CAPTCHA is required
+type SecuritySettings struct {
+	AnonymousAccessEnabled bool `json:"anonymousAccessEnabled"`
+	SignupEnabled          bool `json:"signupEnabled"`
+	SignupCaptchaEnabled   bool `json:"signupCaptchaEnabled"`
+	LoginCaptchaEnabled    bool `json:"loginCaptchaEnabled"`
+	LoginCaptchaThreshold  int  `json:"loginCaptchaThreshold"`
+}
+
+// SecuritySettings returns the global security settings of the server
+func (s *ServerService) SecuritySettings() (*SecuritySettings, *http.Response, error) {
+	request, err := s.client.NewRequest(http.MethodGet, "admin/securitySettings", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	settings := SecuritySettings{}
+	response, err := s.client.Do(request, &settings)
+	if err != nil {
+		return nil, response, err
+	}
+
+	if response.StatusCode != 200 {
+		return nil, response, &simpleError{fmt.Sprintf("Getting security settings returned %s", response.Status)}
+	}
+
+	return &settings, response, nil
+}
+
+// UpdateSecuritySettings replaces the global security settings of the server
+func (s *ServerService) UpdateSecuritySettings(settings *SecuritySettings) (*http.Response, error) {
+	if settings == nil {
+		return nil, &simpleError{"Security settings cannot be empty"}
+	}
+
+	request, err := s.client.NewRequest(http.MethodPut, "admin/securitySettings", settings)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.client.Do(request, nil)
+	if err != nil {
+		return response, err
+	}
+
+	if response.StatusCode != 200 && response.StatusCode != 204 {
+		return response, &simpleError{fmt.Sprintf("Updating security settings returned %s", response.Status)}
+	}
+
+	return response, nil
+}
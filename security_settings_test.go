@@ -0,0 +1,53 @@
+package bamboo_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	bamboo "github.com/sukhyun/go-bamboo"
+)
+
+func TestSecuritySettings(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(securitySettingsStub))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	settings, _, err := client.Server.SecuritySettings()
+	assert.NoError(t, err)
+	assert.True(t, settings.AnonymousAccessEnabled)
+	assert.True(t, settings.SignupEnabled)
+
+	settings.AnonymousAccessEnabled = false
+	settings.SignupEnabled = false
+	_, err = client.Server.UpdateSecuritySettings(settings)
+	assert.NoError(t, err)
+
+	_, err = client.Server.UpdateSecuritySettings(nil)
+	assert.Error(t, err)
+}
+
+func securitySettingsStub(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/rest/api/latest/admin/securitySettings" {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		w.Write([]byte(`{"anonymousAccessEnabled":true,"signupEnabled":true,"signupCaptchaEnabled":false,"loginCaptchaEnabled":true,"loginCaptchaThreshold":3}`))
+	case http.MethodPut:
+		settings := bamboo.SecuritySettings{}
+		if err := json.NewDecoder(r.Body).Decode(&settings); err != nil || settings.AnonymousAccessEnabled || settings.SignupEnabled || settings.LoginCaptchaThreshold != 3 {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusBadRequest)
+	}
+}
@@ -127,7 +127,7 @@ func (s *ServerService) Reindex() (*ReindexState, *http.Response, error) {
 	return state, response, nil
 }
 
-// ReindexStatus will start a server reindex
+// ReindexStatus returns the state of a server reindex
 func (s *ServerService) ReindexStatus() (*ReindexState, *http.Response, error) {
 	u := "reindex"
 	request, err := s.client.NewRequest(http.MethodGet, u, nil)
@@ -1,8 +1,11 @@
 package bamboo
 
 import (
+	"context"
 	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
 )
 
 // InfoService retrieves server information
@@ -17,6 +20,49 @@ type BuildInfo struct {
 	State       string `json:"state,omitempty"`
 }
 
+// AtLeast reports whether the server version is the given dotted version, e.g. "6.10", or newer
+func (b *BuildInfo) AtLeast(version string) (bool, error) {
+	have, err := parseVersion(b.Version)
+	if err != nil {
+		return false, err
+	}
+	want, err := parseVersion(version)
+	if err != nil {
+		return false, err
+	}
+
+	// Missing parts count as zero, "6" is the same version as "6.0.0"
+	for i := range want {
+		part := 0
+		if i < len(have) {
+			part = have[i]
+		}
+		if part != want[i] {
+			return part > want[i], nil
+		}
+	}
+	return true, nil
+}
+
+// parseVersion splits a dotted version such as "6.10.4" into its numbers, ignoring
+// any qualifier like "-SNAPSHOT"
+func parseVersion(version string) ([]int, error) {
+	if i := strings.IndexAny(version, "-+ "); i >= 0 {
+		version = version[:i]
+	}
+
+	parts := strings.Split(version, ".")
+	numbers := make([]int, len(parts))
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil {
+			return nil, &simpleError{fmt.Sprintf("%q is not a version", version)}
+		}
+		numbers[i] = n
+	}
+	return numbers, nil
+}
+
 // ServerInfo contains information on the Bamboo server
 type ServerInfo struct {
 	State             string `json:"state"`
@@ -50,11 +96,16 @@ func (i *InfoService) BuildInfo() (*BuildInfo, *http.Response, error) {
 
 // ServerInfo fetches the Bamboo server information
 func (i *InfoService) ServerInfo() (*ServerInfo, *http.Response, error) {
+	return i.serverInfo(context.Background())
+}
+
+func (i *InfoService) serverInfo(ctx context.Context) (*ServerInfo, *http.Response, error) {
 	u := "server.json"
 	request, err := i.client.NewRequest(http.MethodGet, u, nil)
 	if err != nil {
 		return nil, nil, err
 	}
+	request = request.WithContext(ctx)
 
 	serverInfo := &ServerInfo{}
 	response, err := i.client.Do(request, serverInfo)
@@ -0,0 +1,45 @@
+package bamboo_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	bamboo "github.com/sukhyun/go-bamboo"
+)
+
+func TestBuildInfo(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/latest/info.json" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(`{"version":"6.10.4","edition":"","buildDate":"2019-11-19T12:16:07.000+01:00","buildNumber":"61008","state":"RUNNING"}`))
+	}))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	info, _, err := client.Info.BuildInfo()
+	assert.NoError(t, err)
+	assert.Equal(t, "61008", info.BuildNumber)
+	assert.Equal(t, bamboo.RunningState, info.State)
+
+	for version, expected := range map[string]bool{"6": true, "6.10": true, "6.10.4": true, "6.10.5": false, "6.9.2": true, "7.0": false, "6.10.4.0": true} {
+		ok, err := info.AtLeast(version)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, ok, version)
+	}
+
+	short := &bamboo.BuildInfo{Version: "6"}
+	for version, expected := range map[string]bool{"6.0.0": true, "6.0.1": false, "5.14": true} {
+		ok, err := short.AtLeast(version)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, ok, version)
+	}
+
+	_, err = info.AtLeast("seven")
+	assert.Error(t, err)
+}
@@ -23,7 +23,7 @@ func TestStateTransitions(t *testing.T) {
 	ts := httptest.NewServer(http.HandlerFunc(transitionServerStateStub))
 	defer ts.Close()
 
-	client := bamboo.NewSimpleClient(nil, "", "")
+	client := bamboo.NewSimpleClient(nil, "", "", "")
 	client.SetURL(ts.URL)
 
 	var testCases = []struct {
@@ -52,7 +52,7 @@ func TestReindex(t *testing.T) {
 	ts := httptest.NewServer(http.HandlerFunc(reindexServerStateStub))
 	defer ts.Close()
 
-	client := bamboo.NewSimpleClient(nil, "", "")
+	client := bamboo.NewSimpleClient(nil, "", "", "")
 	client.SetURL(ts.URL)
 
 	var testCases = []struct {
@@ -61,6 +61,8 @@ func TestReindex(t *testing.T) {
 	}{
 		{true, client.Server.Reindex},
 		{true, client.Server.ReindexStatus},
+		{true, client.Reindex.Start},
+		{true, client.Reindex.Status},
 	}
 
 	for _, c := range testCases {
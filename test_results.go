@@ -0,0 +1,91 @@
+package bamboo
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// PassedTestStatus is the status of a test case which passed
+const PassedTestStatus string = "successful"
+
+// FailedTestStatus is the status of a test case which failed
+const FailedTestStatus string = "failed"
+
+// SkippedTestStatus is the status of a test case which was skipped
+const SkippedTestStatus string = "skipped"
+
+// TestResults holds the test outcome of a job result
+// - All:        Number of tests which ran
+// - NewFailed:  Number of tests which failed for the first time
+// - Fixed:      Number of tests which passed after previously failing
+type TestResults struct {
+	All            int        `json:"all"`
+	Successful     int        `json:"successful"`
+	Failed         int        `json:"failed"`
+	NewFailed      int        `json:"newFailed"`
+	ExistingFailed int        `json:"existingFailed"`
+	Fixed          int        `json:"fixed"`
+	Quarantined    int        `json:"quarantined"`
+	Skipped        int        `json:"skipped"`
+	AllTests       *TestCases `json:"allTests,omitempty"`
+	FailedTests    *TestCases `json:"failedTests,omitempty"`
+	NewFailedTests *TestCases `json:"newFailedTests,omitempty"`
+}
+
+// TestCases is a collection of test cases
+type TestCases struct {
+	*CollectionMetadata
+	TestCaseList []*TestCase `json:"testResult"`
+}
+
+// TestCase is the outcome of a single test case
+// - Duration: How long the test ran in milliseconds
+// - Status:   e.g. PassedTestStatus or FailedTestStatus
+type TestCase struct {
+	TestCaseID int    `json:"testCaseId"`
+	ClassName  string `json:"className"`
+	MethodName string `json:"methodName"`
+	Status     string `json:"status"`
+	Duration   int64  `json:"duration"`
+}
+
+// Elapsed returns how long the test ran
+func (t *TestCase) Elapsed() time.Duration {
+	return time.Duration(t.Duration) * time.Millisecond
+}
+
+type testResultsResponse struct {
+	TestResults *TestResults `json:"testResults"`
+}
+
+// GetTestResults returns all, failed and newly failed tests of the given job result
+func (r *ResultService) GetTestResults(resultKey string) (*TestResults, *http.Response, error) {
+	if emptyStrings(resultKey) {
+		return nil, nil, &simpleError{"Result key cannot be empty"}
+	}
+
+	request, err := r.client.NewRequest(http.MethodGet, fmt.Sprintf("%s/%s.json", resultsBase, resultKey), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	values := request.URL.Query()
+	values.Set("expand", "testResults.allTests,testResults.failedTests,testResults.newFailedTests")
+	request.URL.RawQuery = values.Encode()
+
+	result := testResultsResponse{}
+	response, err := r.client.Do(request, &result)
+	if err != nil {
+		return nil, response, err
+	}
+
+	if response.StatusCode != 200 {
+		return nil, response, &simpleError{fmt.Sprintf("Getting test results of %s returned %s", resultKey, response.Status)}
+	}
+
+	if result.TestResults == nil {
+		return &TestResults{}, response, nil
+	}
+	return result.TestResults, response, nil
+}
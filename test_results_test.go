@@ -0,0 +1,57 @@
+package bamboo_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	bamboo "github.com/sukhyun/go-bamboo"
+)
+
+func TestGetTestResults(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(testResultsStub))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	results, response, err := client.Results.GetTestResults("CORE-TEST-JOB1-7")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	assert.Equal(t, 2, results.All)
+	assert.Equal(t, 2, len(results.AllTests.TestCaseList))
+	assert.Equal(t, "testFlaky", results.NewFailedTests.TestCaseList[0].MethodName)
+	assert.Equal(t, 1500*time.Millisecond, results.FailedTests.TestCaseList[0].Elapsed())
+}
+
+func testResultsStub(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/rest/api/latest/result/CORE-TEST-JOB1-7.json" ||
+		r.URL.Query().Get("expand") != "testResults.allTests,testResults.failedTests,testResults.newFailedTests" {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	passed := &bamboo.TestCase{ClassName: "com.example.FooTest", MethodName: "testFoo", Status: bamboo.PassedTestStatus, Duration: 20}
+	failed := &bamboo.TestCase{ClassName: "com.example.FooTest", MethodName: "testFlaky", Status: bamboo.FailedTestStatus, Duration: 1500}
+	resp := map[string]*bamboo.TestResults{
+		"testResults": &bamboo.TestResults{
+			All:            2,
+			Successful:     1,
+			Failed:         1,
+			NewFailed:      1,
+			AllTests:       &bamboo.TestCases{TestCaseList: []*bamboo.TestCase{passed, failed}},
+			FailedTests:    &bamboo.TestCases{TestCaseList: []*bamboo.TestCase{failed}},
+			NewFailedTests: &bamboo.TestCases{TestCaseList: []*bamboo.TestCase{failed}},
+		},
+	}
+
+	bytes, err := json.Marshal(resp)
+	if err != nil {
+		panic(err)
+	}
+
+	w.Write(bytes)
+}
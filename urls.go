@@ -97,3 +97,11 @@ func anonymousRolePermissionsURL(resource, key string) string {
 	}
 	return fmt.Sprintf(permissionBase+"/%s/roles/ANONYMOUS", resource, key)
 }
+
+// -- Downloads --
+// Downloads are served from the server root rather than the REST API
+const downloadBase = "../../../download"
+
+func buildLogURL(jobKey, jobResultKey string) string {
+	return fmt.Sprintf(downloadBase+"/%s/build_logs/%s.log", jobKey, jobResultKey)
+}
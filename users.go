@@ -0,0 +1,217 @@
+package bamboo
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+const usersBase = "admin/users"
+
+// UserService handles administration of local Bamboo user accounts
+type UserService service
+
+// UserListOptions specifies the optional parameters
+// for listing users
+// - Page:   Only return the given page, all users are returned when nil
+// - Filter: Only return users whose name, full name or email contains the filter
+type UserListOptions struct {
+	Page   *Pagination
+	Filter string
+}
+
+type usersResponse struct {
+	*Index
+	Results []*User `json:"results"`
+}
+
+type userCreateRequest struct {
+	Name            string `json:"name"`
+	FullName        string `json:"fullName"`
+	Email           string `json:"email"`
+	Password        string `json:"password"`
+	PasswordConfirm string `json:"passwordConfirm"`
+}
+
+// ListUsers returns the user accounts of the server
+func (u *UserService) ListUsers(options *UserListOptions) ([]*User, *http.Response, error) {
+	filter := ""
+	if options != nil {
+		filter = options.Filter
+		if options.Page != nil {
+			users, response, err := u.listUsersPage(filter, *options.Page)
+			if err != nil {
+				return nil, response, err
+			}
+			return users.Results, response, nil
+		}
+	}
+
+	userList := []*User{}
+	next := Pagination{Limit: defaultPageSize}
+	for {
+		users, response, err := u.listUsersPage(filter, next)
+		if err != nil {
+			return nil, response, err
+		}
+
+		userList = append(userList, users.Results...)
+		next.Start += len(users.Results)
+		if len(users.Results) == 0 || !users.hasMore(len(users.Results)) {
+			return userList, response, nil
+		}
+	}
+}
+
+func (u *UserService) listUsersPage(filter string, page Pagination) (*usersResponse, *http.Response, error) {
+	request, err := u.client.NewRequest(http.MethodGet, usersBase, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	values := request.URL.Query()
+	page.setQuery(values)
+	if filter != "" {
+		values.Set("filter", filter)
+	}
+	request.URL.RawQuery = values.Encode()
+
+	users := usersResponse{}
+	response, err := u.client.Do(request, &users)
+	if err != nil {
+		return nil, response, err
+	}
+
+	if response.StatusCode != 200 {
+		return nil, response, &simpleError{fmt.Sprintf("Listing users returned %s", response.Status)}
+	}
+
+	return &users, response, nil
+}
+
+// CreateUser creates a local user account with the given name, full name, email and password
+func (u *UserService) CreateUser(user *User, password string) (*http.Response, error) {
+	if user == nil || emptyStrings(user.Name, user.FullName, user.Email, password) {
+		return nil, &simpleError{"User name, full name, email and password cannot be empty"}
+	}
+
+	body := &userCreateRequest{
+		Name:            user.Name,
+		FullName:        user.FullName,
+		Email:           user.Email,
+		Password:        password,
+		PasswordConfirm: password,
+	}
+	request, err := u.client.NewRequest(http.MethodPost, usersBase, body)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := u.client.Do(request, nil)
+	if err != nil {
+		return response, err
+	}
+
+	if response.StatusCode != 200 && response.StatusCode != 201 && response.StatusCode != 204 {
+		return response, &simpleError{fmt.Sprintf("Creating user %s returned %s", user.Name, response.Status)}
+	}
+
+	return response, nil
+}
+
+// DeleteUser deletes the local user account with the given name
+func (u *UserService) DeleteUser(username string) (*http.Response, error) {
+	if emptyStrings(username) {
+		return nil, &simpleError{"User name cannot be empty"}
+	}
+
+	request, err := u.client.NewRequest(http.MethodDelete, fmt.Sprintf("%s/%s", usersBase, url.PathEscape(username)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := u.client.Do(request, nil)
+	if err != nil {
+		return response, err
+	}
+
+	if response.StatusCode == 404 {
+		return response, ErrNotFound
+	}
+
+	if response.StatusCode != 200 && response.StatusCode != 204 {
+		return response, &simpleError{fmt.Sprintf("Deleting user %s returned %s", username, response.Status)}
+	}
+
+	return response, nil
+}
+
+type userUpdateRequest struct {
+	FullName string `json:"fullName,omitempty"`
+	Email    string `json:"email,omitempty"`
+}
+
+type passwordChangeRequest struct {
+	NewPassword     string `json:"newPassword"`
+	PasswordConfirm string `json:"passwordConfirm"`
+}
+
+// UpdateUser changes the full name and email of the given local user account.
+// Empty fields are left unchanged.
+func (u *UserService) UpdateUser(user *User) (*http.Response, error) {
+	if user == nil || emptyStrings(user.Name) {
+		return nil, &simpleError{"User name cannot be empty"}
+	}
+	if user.FullName == "" && user.Email == "" {
+		return nil, &simpleError{"Either full name or email must be set"}
+	}
+
+	body := &userUpdateRequest{FullName: user.FullName, Email: user.Email}
+	request, err := u.client.NewRequest(http.MethodPut, fmt.Sprintf("%s/%s", usersBase, url.PathEscape(user.Name)), body)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := u.client.Do(request, nil)
+	if err != nil {
+		return response, err
+	}
+
+	if response.StatusCode == 404 {
+		return response, ErrNotFound
+	}
+
+	if response.StatusCode != 200 && response.StatusCode != 204 {
+		return response, &simpleError{fmt.Sprintf("Updating user %s returned %s", user.Name, response.Status)}
+	}
+
+	return response, nil
+}
+
+// SetPassword replaces the password of the given local user account
+func (u *UserService) SetPassword(username, password string) (*http.Response, error) {
+	if emptyStrings(username, password) {
+		return nil, &simpleError{"User name and password cannot be empty"}
+	}
+
+	body := &passwordChangeRequest{NewPassword: password, PasswordConfirm: password}
+	request, err := u.client.NewRequest(http.MethodPost, fmt.Sprintf("%s/%s/change-password", usersBase, url.PathEscape(username)), body)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := u.client.Do(request, nil)
+	if err != nil {
+		return response, err
+	}
+
+	if response.StatusCode == 404 {
+		return response, ErrNotFound
+	}
+
+	if response.StatusCode != 200 && response.StatusCode != 204 {
+		return response, &simpleError{fmt.Sprintf("Setting the password of user %s returned %s", username, response.Status)}
+	}
+
+	return response, nil
+}
@@ -0,0 +1,108 @@
+package bamboo_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	bamboo "github.com/sukhyun/go-bamboo"
+)
+
+func TestListUsers(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(usersStub))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	users, _, err := client.Users.ListUsers(&bamboo.UserListOptions{Filter: "example.com"})
+	assert.NoError(t, err)
+	if assert.Len(t, users, 2) {
+		assert.Equal(t, "jdoe", users[0].Name)
+		assert.Equal(t, "asmith@example.com", users[1].Email)
+	}
+}
+
+func TestCreateAndDeleteUser(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(usersStub))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	user := &bamboo.User{Name: "newhire", FullName: "New Hire", Email: "newhire@example.com"}
+	_, err := client.Users.CreateUser(user, "s3cret")
+	assert.NoError(t, err)
+	_, err = client.Users.CreateUser(user, "")
+	assert.Error(t, err)
+
+	_, err = client.Users.DeleteUser("newhire")
+	assert.NoError(t, err)
+	_, err = client.Users.DeleteUser("nobody")
+	assert.Equal(t, bamboo.ErrNotFound, err)
+}
+
+func TestUpdateUser(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(usersStub))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	_, err := client.Users.UpdateUser(&bamboo.User{Name: "jdoe", Email: "jane.doe@example.com"})
+	assert.NoError(t, err)
+	_, err = client.Users.UpdateUser(&bamboo.User{Name: "jdoe"})
+	assert.Error(t, err)
+
+	_, err = client.Users.SetPassword("jdoe", "n3w-s3cret")
+	assert.NoError(t, err)
+	_, err = client.Users.SetPassword("jdoe", "")
+	assert.Error(t, err)
+}
+
+func usersStub(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == "/rest/api/latest/admin/users":
+		if r.URL.Query().Get("filter") != "example.com" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Write([]byte(`{"start":0,"limit":100,"results":[
+			{"name":"jdoe","fullName":"Jane Doe","email":"jdoe@example.com"},
+			{"name":"asmith","fullName":"Alex Smith","email":"asmith@example.com"}
+		]}`))
+	case r.Method == http.MethodPost && r.URL.Path == "/rest/api/latest/admin/users":
+		body := map[string]string{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body["name"] != "newhire" || body["password"] != body["passwordConfirm"] {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodPut && r.URL.Path == "/rest/api/latest/admin/users/jdoe":
+		body := map[string]string{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body["email"] != "jane.doe@example.com" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if _, ok := body["fullName"]; ok {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodPost && r.URL.Path == "/rest/api/latest/admin/users/jdoe/change-password":
+		body := map[string]string{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body["newPassword"] != "n3w-s3cret" || body["passwordConfirm"] != body["newPassword"] {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodDelete && r.URL.Path == "/rest/api/latest/admin/users/newhire":
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodDelete:
+		w.WriteHeader(http.StatusNotFound)
+	default:
+		w.WriteHeader(http.StatusBadRequest)
+	}
+}
@@ -0,0 +1,111 @@
+package bamboo
+
+import (
+	"context"
+	"time"
+)
+
+// UtilizationOptions specifies the optional parameters
+// for sampling agent utilization
+// - Samples:  How many times to sample the agents and build queue. Defaults to 10.
+// - Interval: Delay between two samples. Defaults to 1 minute.
+type UtilizationOptions struct {
+	Samples  int
+	Interval time.Duration
+}
+
+// UtilizationReport aggregates the agent and build queue samples taken by SampleUtilization
+// - OnlineAgents:      Average number of online agents
+// - BusyAgents:        Average number of busy agents
+// - Utilization:       Average share of online agents which were busy, between 0 and 1
+// - AgentUtilization:  Share of samples in which each agent was busy, keyed by agent ID
+// - QueueDepth:        Average number of builds waiting in the queue
+// - PeakBusyAgents:    Most agents busy in a single sample
+// - PeakQueueDepth:    Most builds waiting in a single sample
+type UtilizationReport struct {
+	Samples          int
+	OnlineAgents     float64
+	BusyAgents       float64
+	Utilization      float64
+	AgentUtilization map[int64]float64
+	QueueDepth       float64
+	PeakBusyAgents   int
+	PeakQueueDepth   int
+}
+
+// SampleUtilization samples which online agents are busy and how many builds are queued,
+// waiting between samples, and returns the aggregated report. It stops early if ctx is
+// cancelled, reporting the samples taken so far along with the context's error.
+func (a *AgentService) SampleUtilization(ctx context.Context, options *UtilizationOptions) (*UtilizationReport, error) {
+	samples, interval := 10, time.Minute
+	if options != nil && options.Samples > 0 {
+		samples = options.Samples
+	}
+	if options != nil && options.Interval > 0 {
+		interval = options.Interval
+	}
+
+	report := &UtilizationReport{AgentUtilization: map[int64]float64{}}
+	var utilization float64
+	for {
+		agents, _, err := a.ListAgents(&AgentListOptions{Online: true})
+		if err != nil {
+			return report.average(utilization), err
+		}
+		queued, _, err := (*QueueService)(a).queuedBuilds()
+		if err != nil {
+			return report.average(utilization), err
+		}
+
+		busy := 0
+		for _, agent := range agents {
+			if agent.Busy {
+				busy++
+				report.AgentUtilization[agent.ID]++
+			} else if _, ok := report.AgentUtilization[agent.ID]; !ok {
+				report.AgentUtilization[agent.ID] = 0
+			}
+		}
+		if len(agents) > 0 {
+			utilization += float64(busy) / float64(len(agents))
+		}
+
+		report.Samples++
+		report.OnlineAgents += float64(len(agents))
+		report.BusyAgents += float64(busy)
+		report.QueueDepth += float64(len(queued))
+		if busy > report.PeakBusyAgents {
+			report.PeakBusyAgents = busy
+		}
+		if len(queued) > report.PeakQueueDepth {
+			report.PeakQueueDepth = len(queued)
+		}
+
+		if report.Samples == samples {
+			return report.average(utilization), nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return report.average(utilization), ctx.Err()
+		case <-time.After(interval):
+		}
+	}
+}
+
+// average turns the sums collected while sampling into averages
+func (r *UtilizationReport) average(utilization float64) *UtilizationReport {
+	if r.Samples == 0 {
+		return r
+	}
+
+	n := float64(r.Samples)
+	r.OnlineAgents /= n
+	r.BusyAgents /= n
+	r.QueueDepth /= n
+	r.Utilization = utilization / n
+	for id := range r.AgentUtilization {
+		r.AgentUtilization[id] /= n
+	}
+	return r
+}
@@ -0,0 +1,48 @@
+package bamboo_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	bamboo "github.com/sukhyun/go-bamboo"
+)
+
+func TestSampleUtilization(t *testing.T) {
+	samples := []struct{ agents, queue string }{
+		{`[{"id":1,"active":true,"busy":true},{"id":2,"active":true,"busy":false}]`,
+			`{"queuedBuilds":{"size":1,"queuedBuild":[{"planKey":"CORE-TEST","buildResultKey":"CORE-TEST-10"}]}}`},
+		{`[{"id":1,"active":true,"busy":true},{"id":2,"active":true,"busy":true}]`,
+			`{"queuedBuilds":{"size":3,"queuedBuild":[{"buildResultKey":"CORE-TEST-10"},{"buildResultKey":"CORE-TEST-11"},{"buildResultKey":"CORE-OTHER-4"}]}}`},
+	}
+	sample := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/rest/api/latest/agent":
+			w.Write([]byte(samples[sample].agents))
+		case "/rest/api/latest/queue.json":
+			w.Write([]byte(samples[sample].queue))
+			sample++
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	options := &bamboo.UtilizationOptions{Samples: 2, Interval: time.Millisecond}
+	report, err := client.Agents.SampleUtilization(context.Background(), options)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, report.Samples)
+	assert.Equal(t, 1.5, report.BusyAgents)
+	assert.Equal(t, 0.75, report.Utilization)
+	assert.Equal(t, 2.0, report.QueueDepth)
+	assert.Equal(t, 2, report.PeakBusyAgents)
+	assert.Equal(t, 3, report.PeakQueueDepth)
+	assert.Equal(t, map[int64]float64{1: 1, 2: 0.5}, report.AgentUtilization)
+}
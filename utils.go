@@ -3,8 +3,15 @@ package bamboo
 import (
 	"fmt"
 	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
 )
 
+// defaultPageSize is the number of resources requested per page when a method
+// pages through an entire collection
+const defaultPageSize = 100
+
 func emptyStrings(strings ...string) bool {
 	for _, s := range strings {
 		if s == "" {
@@ -25,3 +32,41 @@ type Pagination struct {
 	Start int
 	Limit int
 }
+
+// setQuery sets the start and limit parameters of a request to a paginated resource
+func (p *Pagination) setQuery(values url.Values) {
+	if p == nil {
+		return
+	}
+	if p.Start > 0 {
+		values.Set("start", strconv.Itoa(p.Start))
+	}
+	if p.Limit > 0 {
+		values.Set("limit", strconv.Itoa(p.Limit))
+	}
+}
+
+// setIndexQuery sets the start-index and max-results parameters of a request to a resource
+// which returns a collection
+func (p *Pagination) setIndexQuery(values url.Values) {
+	if p == nil {
+		return
+	}
+	if p.Start > 0 {
+		values.Set("start-index", strconv.Itoa(p.Start))
+	}
+	if p.Limit > 0 {
+		values.Set("max-results", strconv.Itoa(p.Limit))
+	}
+}
+
+// joinExpand combines expand parameter values into a single value
+func joinExpand(expands ...string) string {
+	nonEmpty := []string{}
+	for _, e := range expands {
+		if e != "" {
+			nonEmpty = append(nonEmpty, e)
+		}
+	}
+	return strings.Join(nonEmpty, ",")
+}
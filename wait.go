@@ -0,0 +1,152 @@
+package bamboo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// defaultWaitInterval is the delay before the first poll of a build
+const defaultWaitInterval = 5 * time.Second
+
+// defaultMaxWaitInterval is the longest delay between two polls of a build
+const defaultMaxWaitInterval = time.Minute
+
+// WaitOptions specifies the optional parameters
+// for waiting on a build
+// - Interval:    Delay before the first poll, doubled after every poll. Defaults to 5 seconds.
+// - MaxInterval: Longest delay between two polls. Defaults to 1 minute.
+type WaitOptions struct {
+	Interval    time.Duration
+	MaxInterval time.Duration
+}
+
+func (o *WaitOptions) intervals() (time.Duration, time.Duration) {
+	interval, max := defaultWaitInterval, defaultMaxWaitInterval
+	if o != nil && o.Interval > 0 {
+		interval = o.Interval
+	}
+	if o != nil && o.MaxInterval > 0 {
+		max = o.MaxInterval
+	}
+	if interval > max {
+		interval = max
+	}
+	return interval, max
+}
+
+// WaitForBuild polls the given result, e.g. "PROJ-PLAN-123", until the build has finished or
+// ctx is cancelled, and returns the final result
+func (r *ResultService) WaitForBuild(ctx context.Context, resultKey string, options *WaitOptions) (*Result, *http.Response, error) {
+	if _, _, ok := splitResultKey(resultKey); !ok {
+		return nil, nil, &simpleError{fmt.Sprintf("%q is not a result key", resultKey)}
+	}
+
+	interval, max := options.intervals()
+	for {
+		result, response, err := r.numberedResult(ctx, resultKey)
+		switch {
+		case response != nil && response.StatusCode == 404:
+			// A freshly queued build may not have a result yet
+		case err != nil:
+			return nil, response, err
+		case result.Finished || result.LifeCycleState == FinishedLifeCycleState || result.LifeCycleState == NotBuiltLifeCycleState:
+			return result, response, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, response, ctx.Err()
+		case <-time.After(interval):
+		}
+
+		if interval *= 2; interval > max {
+			interval = max
+		}
+	}
+}
+
+// QueueBuildAndWait queues a build of the given plan or plan branch and waits until it has
+// finished or ctx is cancelled, see WaitForBuild
+func (q *QueueService) QueueBuildAndWait(ctx context.Context, planKey string, options *QueueBuildOptions, waitOptions *WaitOptions) (*Result, *http.Response, error) {
+	queued, response, err := q.QueueBuild(planKey, options)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return (*ResultService)(q).WaitForBuild(ctx, queued.BuildResultKey, waitOptions)
+}
+
+// WaitForDeployment polls the given deployment result until the deployment has finished or
+// ctx is cancelled, and returns its final state
+func (d *DeployService) WaitForDeployment(ctx context.Context, deploymentResultID int, options *WaitOptions) (*DeployStatus, error) {
+	interval, max := options.intervals()
+	for {
+		status, response, err := d.deployStatus(ctx, deploymentResultID)
+		switch {
+		case response != nil && response.StatusCode == 404:
+			// A freshly queued deployment may not have a result yet
+		case err != nil:
+			return nil, err
+		case status.LifeCycleState == FinishedDeploymentLifeCycleState || status.LifeCycleState == NotBuiltDeploymentLifeCycleState:
+			return status, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(interval):
+		}
+
+		if interval *= 2; interval > max {
+			interval = max
+		}
+	}
+}
+
+// QueueDeploymentAndWait deploys the given release to the given environment and waits until
+// the deployment has finished or ctx is cancelled, see WaitForDeployment
+func (d *DeployService) QueueDeploymentAndWait(ctx context.Context, environmentID, versionID int, options *QueueDeploymentOptions, waitOptions *WaitOptions) (*DeployStatus, error) {
+	deploymentResultID, err := d.QueueDeployment(environmentID, versionID, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return d.WaitForDeployment(ctx, deploymentResultID, waitOptions)
+}
+
+// PauseAndWait pauses the Bamboo server and waits until builds which were already running have
+// finished and the server reached the PAUSED state, or ctx is cancelled
+func (s *ServerService) PauseAndWait(ctx context.Context, options *WaitOptions) (*ServerInfo, *http.Response, error) {
+	state, response, err := s.Pause()
+	if err != nil {
+		return nil, response, err
+	}
+	if state.State == PausedState {
+		return &state.ServerInfo, response, nil
+	}
+
+	interval, max := options.intervals()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, response, ctx.Err()
+		case <-time.After(interval):
+		}
+
+		info, response, err := (*InfoService)(s).serverInfo(ctx)
+		switch {
+		case err != nil:
+			return nil, response, err
+		case info.State == PausedState:
+			return info, response, nil
+		case info.State != PausingState:
+			return nil, response, &simpleError{fmt.Sprintf("Server moved to %s while pausing", info.State)}
+		}
+
+		if interval *= 2; interval > max {
+			interval = max
+		}
+	}
+}
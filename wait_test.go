@@ -0,0 +1,139 @@
+package bamboo_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	bamboo "github.com/sukhyun/go-bamboo"
+)
+
+func TestQueueBuildAndWait(t *testing.T) {
+	polls := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/rest/api/latest/queue/CORE-TEST.json":
+			w.Write([]byte(`{"planKey":"CORE-TEST","buildNumber":11,"buildResultKey":"CORE-TEST-11"}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/rest/api/latest/result/CORE-TEST-11":
+			polls++
+			switch polls {
+			case 1:
+				w.WriteHeader(http.StatusNotFound)
+			case 2:
+				w.Write([]byte(`{"key":"CORE-TEST-11","lifeCycleState":"InProgress"}`))
+			default:
+				w.Write([]byte(`{"key":"CORE-TEST-11","lifeCycleState":"Finished","finished":true,"buildState":"Successful"}`))
+			}
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	options := &bamboo.WaitOptions{Interval: time.Millisecond, MaxInterval: 2 * time.Millisecond}
+	result, _, err := client.Queue.QueueBuildAndWait(context.Background(), "CORE-TEST", nil, options)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, polls)
+	assert.Equal(t, bamboo.SuccessfulBuildState, result.BuildState)
+}
+
+func TestWaitForBuildCancelled(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"key":"CORE-TEST-12","lifeCycleState":"Queued"}`))
+	}))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	options := &bamboo.WaitOptions{Interval: time.Millisecond}
+	_, _, err := client.Results.WaitForBuild(ctx, "CORE-TEST-12", options)
+	assert.Error(t, err)
+}
+
+func TestQueueDeploymentAndWait(t *testing.T) {
+	polls := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/rest/api/latest/queue/deployment/":
+			w.Write([]byte(`{"deploymentResultId":2049}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/rest/api/latest/deploy/result/2049":
+			polls++
+			if polls < 3 {
+				w.Write([]byte(`{"deploymentState":"UNKNOWN","lifeCycleState":"IN_PROGRESS"}`))
+				return
+			}
+			w.Write([]byte(`{"deploymentState":"SUCCESS","lifeCycleState":"FINISHED"}`))
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	options := &bamboo.WaitOptions{Interval: time.Millisecond}
+	status, err := client.Deploys.QueueDeploymentAndWait(context.Background(), 131, 1015, nil, options)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, polls)
+	assert.Equal(t, bamboo.SuccessfulDeploymentState, status.DeploymentState)
+}
+
+func TestWaitForStoppedDeployment(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/latest/deploy/result/2050" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Write([]byte(`{"deploymentState":"UNKNOWN","lifeCycleState":"NOT_BUILT"}`))
+	}))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	status, err := client.Deploys.WaitForDeployment(ctx, 2050, &bamboo.WaitOptions{Interval: time.Millisecond})
+	assert.NoError(t, err)
+	assert.Equal(t, bamboo.NotBuiltDeploymentLifeCycleState, status.LifeCycleState)
+}
+
+func TestPauseAndWait(t *testing.T) {
+	polls := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/rest/api/latest/server/pause.json":
+			w.Write([]byte(`{"state":"PAUSING","setByUser":"admin"}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/rest/api/latest/server.json":
+			polls++
+			if polls < 2 {
+				w.Write([]byte(`{"state":"PAUSING"}`))
+			} else {
+				w.Write([]byte(`{"state":"PAUSED"}`))
+			}
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer ts.Close()
+
+	client := bamboo.NewSimpleClient(nil, "", "", "")
+	client.SetURL(ts.URL)
+
+	options := &bamboo.WaitOptions{Interval: time.Millisecond, MaxInterval: 2 * time.Millisecond}
+	info, _, err := client.Server.PauseAndWait(context.Background(), options)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, polls)
+	assert.Equal(t, bamboo.PausedState, info.State)
+}